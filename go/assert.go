@@ -0,0 +1,9 @@
+//go:build !swedishholidays_debug
+
+package swedishholidays
+
+import "time"
+
+// assertWeekday is a no-op, build with the swedishholidays_debug tag to check
+// the calculated holidays at runtime.
+func assertWeekday(name string, d time.Time, weekday time.Weekday) {}
@@ -0,0 +1,16 @@
+//go:build swedishholidays_debug
+
+package swedishholidays
+
+import (
+	"fmt"
+	"time"
+)
+
+// assertWeekday panics if d isn't on the given weekday. It's only active when
+// built with the swedishholidays_debug tag.
+func assertWeekday(name string, d time.Time, weekday time.Weekday) {
+	if d.Weekday() != weekday {
+		panic(fmt.Sprintf("%v %v is a %v, expected %v", name, d.Format(time.DateOnly), d.Weekday(), weekday))
+	}
+}
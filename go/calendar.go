@@ -0,0 +1,146 @@
+package swedishholidays
+
+import (
+	"strings"
+	"time"
+)
+
+// Calendar combines the holiday categories of the package, e.g. the
+// statutory holidays and the eves, into one set. Use New to create one.
+type Calendar struct {
+	statutory      bool
+	eves           bool
+	flagDays       bool
+	churchDays     bool
+	morsOchFarsDag bool
+}
+
+// Option selects a category to include in a Calendar.
+type Option func(*Calendar)
+
+// WithStatutory includes the allmänna helgdagar, see HolidaysOf.
+func WithStatutory() Option {
+	return func(c *Calendar) { c.statutory = true }
+}
+
+// WithEves includes the aftnar, see GetEves.
+func WithEves() Option {
+	return func(c *Calendar) { c.eves = true }
+}
+
+// WithFlagDays includes the allmänna flaggdagar, see FlagDays. Mors dag and
+// Fars dag aren't included, use WithMorsOchFarsDag for them.
+func WithFlagDays() Option {
+	return func(c *Calendar) { c.flagDays = true }
+}
+
+// WithChurchDays includes the days of the church year, see ChurchDays.
+func WithChurchDays() Option {
+	return func(c *Calendar) { c.churchDays = true }
+}
+
+// WithMorsOchFarsDag includes Mors dag and Fars dag.
+func WithMorsOchFarsDag() Option {
+	return func(c *Calendar) { c.morsOchFarsDag = true }
+}
+
+// New creates a Calendar with exactly the categories selected by opts. Without
+// any options only the statutory holidays are included.
+func New(opts ...Option) *Calendar {
+	c := &Calendar{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if len(opts) == 0 {
+		c.statutory = true
+	}
+
+	return c
+}
+
+// Holidays returns the holidays of the configured categories for the given
+// year, merged with MergeHolidays and sorted by date.
+func (c *Calendar) Holidays(y int) ([]Holiday, error) {
+	if err := validateYear(y); err != nil {
+		return nil, err
+	}
+
+	var lists [][]Holiday
+
+	if c.statutory {
+		holidays, err := holidaysOf(y)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lists = append(lists, holidays)
+	}
+
+	if c.eves {
+		eves, err := GetEves(y)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lists = append(lists, eves)
+	}
+
+	if c.flagDays || c.morsOchFarsDag {
+		flagDays, err := FlagDays(y)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var selected []Holiday
+		for _, flagDay := range flagDays {
+			if (isMorsOchFarsDag(flagDay) && c.morsOchFarsDag) || (!isMorsOchFarsDag(flagDay) && c.flagDays) {
+				selected = append(selected, flagDay)
+			}
+		}
+
+		lists = append(lists, selected)
+	}
+
+	if c.churchDays {
+		churchDays, err := ChurchDays(y)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lists = append(lists, churchDays)
+	}
+
+	return MergeHolidays(lists...), nil
+}
+
+// IsHoliday reports whether the given date is a holiday in one of the
+// configured categories and returns its Swedish name, e.g. "Julafton" for a
+// calendar created with WithEves. Only the calendar day of d is used. When
+// several holidays fall on the date their names are joined, e.g.
+// "Första maj, Kristi himmelsfärdsdag".
+func (c *Calendar) IsHoliday(d time.Time) (bool, string, error) {
+	holidays, err := c.Holidays(d.Year())
+
+	if err != nil {
+		return false, "", err
+	}
+
+	var names []string
+	for _, holiday := range holidays {
+		if isSameDay(d, holiday.Date) {
+			names = append(names, holiday.Name)
+		}
+	}
+
+	return len(names) > 0, strings.Join(names, ", "), nil
+}
+
+func isMorsOchFarsDag(h Holiday) bool {
+	return h.Key == "morsdag" || h.Key == "farsdag"
+}
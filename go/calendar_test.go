@@ -0,0 +1,55 @@
+package swedishholidays
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCalendarStatutoryMatchesHolidaysOf(t *testing.T) {
+	for _, y := range []int{2000, 2008, 2024} {
+		got, err := New().Holidays(y)
+
+		if err != nil {
+			t.Fatalf("New().Holidays(%v) returned error: %v", y, err)
+		}
+
+		want, err := HolidaysOf(y)
+
+		if err != nil {
+			t.Fatalf("HolidaysOf(%v) returned error: %v", y, err)
+		}
+
+		if !slices.Equal(got, want) {
+			t.Errorf("New().Holidays(%v) =\n%v\nwant\n%v", y, holidayKeys(got), holidayKeys(want))
+		}
+	}
+}
+
+func TestCalendarIsHoliday(t *testing.T) {
+	tests := []struct {
+		calendar *Calendar
+		d        time.Time
+		found    bool
+		name     string
+	}{
+		{New(), date(2024, time.December, 24), false, ""},
+		{New(WithStatutory(), WithEves()), date(2024, time.December, 24), true, "Julafton"},
+		{New(WithEves()), date(2024, time.December, 25), false, ""},
+		{New(WithMorsOchFarsDag()), date(2024, time.May, 26), true, "Mors dag"},
+		{New(WithFlagDays()), date(2024, time.May, 26), false, ""},
+		{New(), date(2008, time.May, 1), true, "Första maj, Kristi himmelsfärdsdag"},
+	}
+
+	for _, tt := range tests {
+		found, name, err := tt.calendar.IsHoliday(tt.d)
+
+		if err != nil {
+			t.Fatalf("IsHoliday(%v) returned error: %v", tt.d.Format(time.DateOnly), err)
+		}
+
+		if found != tt.found || name != tt.name {
+			t.Errorf("IsHoliday(%v) = %v, %q, want %v, %q", tt.d.Format(time.DateOnly), found, name, tt.found, tt.name)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package swedishholidays
+
+import "time"
+
+// ChurchDays returns days of the church year, like skärtorsdagen, for the
+// given year in chronological order. Most of them aren't allmänna helgdagar,
+// they're kept separate from HolidaysOf.
+func ChurchDays(y int) ([]Holiday, error) {
+	holidays, err := GetHolidaysT(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	churchDays := []Holiday{
+		{Name: "Fettisdagen", Date: calcFettisdagen(holidays.PaskDagen)},
+		{Name: "Askonsdagen", Date: calcAskonsdagen(holidays.PaskDagen)},
+		{Name: "Palmsöndagen", Date: calcPalmsondagen(holidays.PaskDagen)},
+		{Name: "Skärtorsdagen", Date: calcSkartorsdagen(holidays.PaskDagen)},
+		{Name: "Långfredagen", Date: holidays.Langfredagen},
+		{Name: "Påskdagen", Date: holidays.PaskDagen},
+	}
+
+	setDetails(churchDays, ChurchDay)
+	return churchDays, nil
+}
+
+// HolyWeek returns stilla veckan for the given year: palmsöndagen,
+// skärtorsdagen, långfredagen, påskafton and påskdagen.
+func HolyWeek(y int) ([]Holiday, error) {
+	holidays, err := GetHolidaysT(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	holyWeek := []Holiday{
+		{Name: "Palmsöndagen", Date: calcPalmsondagen(holidays.PaskDagen)},
+		{Name: "Skärtorsdagen", Date: calcSkartorsdagen(holidays.PaskDagen)},
+		{Name: "Långfredagen", Date: holidays.Langfredagen},
+		{Name: "Påskafton", Date: calcPaskafton(holidays.PaskDagen)},
+		{Name: "Påskdagen", Date: holidays.PaskDagen},
+	}
+
+	setDetails(holyWeek, ChurchDay)
+	return holyWeek, nil
+}
+
+// Fettisdagen är tisdagen före askonsdagen, 47 dagar före påskdagen
+func calcFettisdagen(p time.Time) (fettisdagen time.Time) {
+	return p.AddDate(0, 0, -47)
+}
+
+// Askonsdagen inleder fastan, 46 dagar före påskdagen
+func calcAskonsdagen(p time.Time) (askonsdagen time.Time) {
+	return p.AddDate(0, 0, -46)
+}
+
+// Palmsöndagen är söndagen före påskdagen
+func calcPalmsondagen(p time.Time) (palmsondagen time.Time) {
+	return p.AddDate(0, 0, -7)
+}
+
+// Skärtorsdagen är torsdagen före påskdagen
+func calcSkartorsdagen(p time.Time) (skartorsdagen time.Time) {
+	return p.AddDate(0, 0, -3)
+}
+
+// AdventSundays returns the four advent sundays of the given year, första
+// advent first. Fjärde advent is the last sunday before juldagen.
+func AdventSundays(y int) ([]time.Time, error) {
+	if err := validateYear(y); err != nil {
+		return nil, err
+	}
+
+	juldagen := time.Date(y, time.December, 25, 0, 0, 0, 0, time.UTC)
+	fjardeAdvent, err := findWeekdayStrict(juldagen, time.Sunday, Backward)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []time.Time{
+		fjardeAdvent.AddDate(0, 0, -21),
+		fjardeAdvent.AddDate(0, 0, -14),
+		fjardeAdvent.AddDate(0, 0, -7),
+		fjardeAdvent,
+	}, nil
+}
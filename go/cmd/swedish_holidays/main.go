@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	swedishholidays "github.com/kottetall/swedish_holidays/go"
+)
+
+func main() {
+	year := flag.Int("year", time.Now().In(swedishholidays.Stockholm).Year(), "the year to list holidays for")
+	format := flag.String("format", "text", "output format: text, json or ics")
+	flag.Parse()
+
+	if err := run(*year, *format); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(year int, format string) error {
+	switch format {
+	case "text":
+		holidays, err := swedishholidays.GetHolidays(year)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(holidays)
+	case "json":
+		holidays, err := swedishholidays.GetHolidays(year)
+
+		if err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(holidays)
+	case "ics":
+		ical, err := swedishholidays.ToICal(year)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Print(ical)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	return nil
+}
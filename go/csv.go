@@ -0,0 +1,71 @@
+package swedishholidays
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var csvHeader = []string{"name", "date", "weekday"}
+
+// ToCSV returns the holidays of the given year as CSV with a name,date,weekday
+// header, e.g. "Midsommardagen,2023-06-24,lördag". The output is UTF-8.
+func ToCSV(y int) (string, error) {
+	var b strings.Builder
+
+	if err := WriteCSV(&b, y, y); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// WriteCSV streams the holidays of the years start to end, both included, to w
+// as CSV, see ToCSV. Each year is flushed before the next one is calculated,
+// so memory use doesn't grow with the range. If a year fails the earlier ones
+// have already been written.
+func WriteCSV(w io.Writer, start int, end int) error {
+	if start > end {
+		return fmt.Errorf("%w: %v is after %v", ErrInvalidRange, start, end)
+	}
+
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for y := start; y <= end; y++ {
+		if err := writeCSVYear(cw, y); err != nil {
+			return err
+		}
+
+		cw.Flush()
+
+		if err := cw.Error(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeCSVYear(w *csv.Writer, y int) error {
+	holidays, err := holidaysOf(y)
+
+	if err != nil {
+		return err
+	}
+
+	for _, holiday := range holidays {
+		row := []string{holiday.Name, holiday.Date.Format(time.DateOnly), holiday.SwedishWeekday()}
+
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package swedishholidays
+
+import "time"
+
+// HolidayDiff compares when a holiday falls in two different years.
+type HolidayDiff struct {
+	Key  string
+	Name string
+	// A and B are the dates in the first and second year. A holiday that only
+	// exists in one of the years, like nationaldagen before 2005, has the zero
+	// time for the other.
+	A time.Time
+	B time.Time
+}
+
+// Moved reports whether the holiday falls on a different date, i.e. month and
+// day, in the two years, or only exists in one of them. Midsommardagen moves
+// every year, juldagen never does.
+func (d HolidayDiff) Moved() bool {
+	if d.A.IsZero() || d.B.IsZero() {
+		return true
+	}
+
+	return d.A.Month() != d.B.Month() || d.A.Day() != d.B.Day()
+}
+
+// WeekdayChanged reports whether the holiday falls on a different weekday in
+// the two years, or only exists in one of them, e.g. to see which holidays
+// moved from a weekend to a weekday.
+func (d HolidayDiff) WeekdayChanged() bool {
+	return d.A.IsZero() || d.B.IsZero() || d.A.Weekday() != d.B.Weekday()
+}
+
+// DiffYears compares the holidays of the years a and b. The result follows
+// the chronological order of year a, holidays only in year b come last.
+func DiffYears(a int, b int) ([]HolidayDiff, error) {
+	holidaysA, err := holidaysOf(a)
+
+	if err != nil {
+		return nil, err
+	}
+
+	holidaysB, err := holidaysOf(b)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []HolidayDiff
+	byKey := make(map[string]int, len(holidaysA))
+
+	for _, holiday := range holidaysA {
+		byKey[holiday.Key] = len(diffs)
+		diffs = append(diffs, HolidayDiff{Key: holiday.Key, Name: holiday.Name, A: holiday.Date})
+	}
+
+	for _, holiday := range holidaysB {
+		if i, ok := byKey[holiday.Key]; ok {
+			diffs[i].B = holiday.Date
+			continue
+		}
+
+		diffs = append(diffs, HolidayDiff{Key: holiday.Key, Name: holiday.Name, B: holiday.Date})
+	}
+
+	return diffs, nil
+}
@@ -0,0 +1,46 @@
+package swedishholidays
+
+import "testing"
+
+func TestDiffYears(t *testing.T) {
+	tests := []struct {
+		a              int
+		b              int
+		key            string
+		moved          bool
+		weekdayChanged bool
+	}{
+		{2024, 2025, "midsommardagen", true, false},
+		{2024, 2025, "paskdagen", true, false},
+		{2024, 2025, "juldagen", false, true},
+		// Annandag pingst was replaced by nationaldagen in 2005
+		{2004, 2005, "annandagpingst", true, true},
+		{2004, 2005, "nationaldagen", true, true},
+		{2004, 2005, "nyarsdagen", false, true},
+	}
+
+	for _, tt := range tests {
+		diffs, err := DiffYears(tt.a, tt.b)
+
+		if err != nil {
+			t.Fatalf("DiffYears(%v, %v) returned error: %v", tt.a, tt.b, err)
+		}
+
+		found := false
+		for _, diff := range diffs {
+			if diff.Key != tt.key {
+				continue
+			}
+
+			found = true
+			if diff.Moved() != tt.moved || diff.WeekdayChanged() != tt.weekdayChanged {
+				t.Errorf("DiffYears(%v, %v) %v: Moved() = %v, WeekdayChanged() = %v, want %v, %v",
+					tt.a, tt.b, tt.key, diff.Moved(), diff.WeekdayChanged(), tt.moved, tt.weekdayChanged)
+			}
+		}
+
+		if !found {
+			t.Errorf("DiffYears(%v, %v) has no %v", tt.a, tt.b, tt.key)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Package swedishholidays calculates the Swedish public holidays (allmänna
+// helgdagar) for a given year.
+//
+// All dates are at midnight UTC. Use the ...In functions, e.g. IsHolidayIn,
+// to check a point in time against the Swedish wall clock.
+//
+// Dates follow the proleptic gregorian calendar for every year from MinYear.
+// Sweden only switched to it in 1753, and used a calendar of its own between
+// 1700 and 1712, so dates before 1753 don't match the ones used in Sweden at
+// the time. CheckSwedishCalendar reports these years.
+//
+// All functions are safe for concurrent use by multiple goroutines.
+package swedishholidays
@@ -0,0 +1,70 @@
+package swedishholidays
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The range of years supported by the package. The lower bound is the
+// introduction of the gregorian calendar, the upper bound keeps the years
+// formattable as yyyy-mm-dd.
+const (
+	MinYear = 1583
+	MaxYear = 9999
+)
+
+// SwedishGregorianYear is the first year Sweden used the gregorian calendar.
+// Results for earlier years follow the gregorian calendar, not the one that
+// was actually used in Sweden, see CheckSwedishCalendar.
+const SwedishGregorianYear = 1753
+
+var (
+	// ErrYearOutOfRange is returned for years outside MinYear-MaxYear.
+	ErrYearOutOfRange = errors.New("year out of range")
+
+	// ErrNoDateFound is returned when a searched for date doesn't exist, e.g.
+	// a fifth monday in february.
+	ErrNoDateFound = errors.New("Fann inget datum")
+
+	// ErrUnknownHoliday is returned when looking up a holiday that doesn't
+	// exist, or doesn't exist in the given year.
+	ErrUnknownHoliday = errors.New("unknown holiday")
+
+	// ErrInvalidRange is returned when the start of a date range is after its
+	// end.
+	ErrInvalidRange = errors.New("invalid date range")
+
+	// ErrPreSwedishGregorian is returned by CheckSwedishCalendar for years
+	// before Sweden adopted the gregorian calendar.
+	ErrPreSwedishGregorian = errors.New("year before the swedish gregorian calendar")
+)
+
+func validateYear(y int) error {
+	if y < MinYear || y > MaxYear {
+		return fmt.Errorf("%w: %v is outside of %v-%v", ErrYearOutOfRange, y, MinYear, MaxYear)
+	}
+
+	return nil
+}
+
+// CheckSwedishCalendar reports whether the dates calculated for the given year
+// match the calendar used in Sweden at the time. Years before
+// SwedishGregorianYear return ErrPreSwedishGregorian, the other functions
+// still calculate them but the dates are gregorian ones.
+func CheckSwedishCalendar(y int) error {
+	if err := validateYear(y); err != nil {
+		return err
+	}
+
+	// Between 1700 and 1712 Sweden used its own calendar, one day ahead of
+	// the julian one, which ended with a february 30 in 1712
+	if y >= 1700 && y <= 1712 {
+		return fmt.Errorf("%w: Sweden used the swedish calendar in %v", ErrPreSwedishGregorian, y)
+	}
+
+	if y < SwedishGregorianYear {
+		return fmt.Errorf("%w: Sweden used the julian calendar in %v", ErrPreSwedishGregorian, y)
+	}
+
+	return nil
+}
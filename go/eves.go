@@ -0,0 +1,50 @@
+package swedishholidays
+
+import "time"
+
+// GetEves returns påskafton, midsommarafton, julafton and nyårsafton for the
+// given year in chronological order.
+//
+// The aftnar aren't allmänna helgdagar, but most workplaces treat them as days
+// off. They are kept separate from HolidaysOf so callers can choose.
+func GetEves(y int) ([]Holiday, error) {
+	holidays, err := GetHolidaysT(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	midsommarafton, err := calcMidsommarafton(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	eves := []Holiday{
+		{Name: "Påskafton", Date: calcPaskafton(holidays.PaskDagen)},
+		{Name: "Midsommarafton", Date: midsommarafton},
+		{Name: "Julafton", Date: time.Date(y, time.December, 24, 0, 0, 0, 0, time.UTC)},
+		{Name: "Nyårsafton", Date: time.Date(y, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	setDetails(eves, Eve)
+
+	return eves, nil
+}
+
+// Påskafton är lördagen före påskdagen
+func calcPaskafton(p time.Time) (paskafton time.Time) {
+	return p.AddDate(0, 0, -1)
+}
+
+// Midsommarafton är fredagen före midsommardagen, den infaller under tiden
+// den 19-25 juni
+func calcMidsommarafton(y int) (midsommarafton time.Time, err error) {
+	midsommarDagen, err := calcMidsommarDagen(y)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return midsommarDagen.AddDate(0, 0, -1), nil
+}
@@ -0,0 +1,41 @@
+package swedishholidays
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalcMidsommarafton(t *testing.T) {
+	tests := []struct {
+		year int
+		want time.Time
+	}{
+		// First and last day of the window
+		{2015, date(2015, time.June, 19)},
+		{2021, date(2021, time.June, 25)},
+		{2023, date(2023, time.June, 23)},
+		{2024, date(2024, time.June, 21)},
+	}
+
+	for _, tt := range tests {
+		got, err := calcMidsommarafton(tt.year)
+
+		if err != nil {
+			t.Fatalf("calcMidsommarafton(%v) returned error: %v", tt.year, err)
+		}
+
+		if !got.Equal(tt.want) || got.Weekday() != time.Friday {
+			t.Errorf("calcMidsommarafton(%v) = %v, want friday %v", tt.year, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+
+		eves, err := GetEves(tt.year)
+
+		if err != nil {
+			t.Fatalf("GetEves(%v) returned error: %v", tt.year, err)
+		}
+
+		if eves[1].Key != "midsommarafton" || !eves[1].Date.Equal(tt.want) {
+			t.Errorf("GetEves(%v) has %v on %v, want Midsommarafton on %v", tt.year, eves[1].Name, eves[1].Date.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package swedishholidays_test
+
+import (
+	"fmt"
+	"time"
+
+	swedishholidays "github.com/kottetall/swedish_holidays/go"
+)
+
+func ExampleGetHolidays() {
+	holidays, err := swedishholidays.GetHolidays(2023)
+
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(holidays)
+	// Output:
+	// Nyårsdagen: 2023-01-01 (söndag)
+	// Trettondedag jul: 2023-01-06 (fredag)
+	// Långfredagen: 2023-04-07 (fredag)
+	// Påskdagen: 2023-04-09 (söndag)
+	// Annandag påsk: 2023-04-10 (måndag)
+	// Första maj: 2023-05-01 (måndag)
+	// Kristi himmelsfärdsdag: 2023-05-18 (torsdag)
+	// Pingstdagen: 2023-05-28 (söndag)
+	// Nationaldagen: 2023-06-06 (tisdag)
+	// Midsommardagen: 2023-06-24 (lördag)
+	// Alla helgons dag: 2023-11-04 (lördag)
+	// Juldagen: 2023-12-25 (måndag)
+	// Annandag jul: 2023-12-26 (tisdag)
+}
+
+func ExampleIsHoliday() {
+	midsommar := time.Date(2023, time.June, 24, 0, 0, 0, 0, time.UTC)
+	isHoliday, err := swedishholidays.IsHoliday(midsommar)
+
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(isHoliday)
+	// Output: true
+}
+
+func ExampleNextHoliday() {
+	// Looks into the next year when needed
+	from := time.Date(2023, time.December, 27, 0, 0, 0, 0, time.UTC)
+	next, err := swedishholidays.NextHoliday(from)
+
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(next.Name, next.Date.Format(time.DateOnly))
+	// Output: Nyårsdagen 2024-01-01
+}
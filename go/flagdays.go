@@ -0,0 +1,82 @@
+package swedishholidays
+
+import (
+	"sort"
+	"time"
+)
+
+// FlagDays returns the allmänna flaggdagar of the given year in chronological
+// order, together with Mors dag and Fars dag which are commonly flagged as
+// well. They're kept separate from the holidays in HolidaysOf.
+//
+// The list follows the current Förordning (1982:270) om allmänna flaggdagar,
+// historical changes (e.g. the royal name- and birthdays) aren't reflected.
+func FlagDays(y int) ([]Holiday, error) {
+	holidays, err := GetHolidaysT(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	morsDag, err := calcMorsDag(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	farsDag, err := calcFarsDag(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	flagDays := []Holiday{
+		{Name: "Nyårsdagen", Date: holidays.NyarsDagen},
+		{Name: "Konungens namnsdag", Date: time.Date(y, time.January, 28, 0, 0, 0, 0, time.UTC)},
+		{Name: "Kronprinsessans namnsdag", Date: time.Date(y, time.March, 12, 0, 0, 0, 0, time.UTC)},
+		{Name: "Påskdagen", Date: holidays.PaskDagen},
+		{Name: "Konungens födelsedag", Date: time.Date(y, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		{Name: "Första maj", Date: holidays.ForstaMaj},
+		{Name: "Mors dag", Date: morsDag},
+		{Name: "Pingstdagen", Date: holidays.PingstDagen},
+		// Nationaldagen was a flag day long before it became a helgdag in 2005
+		{Name: "Sveriges nationaldag", Date: time.Date(y, time.June, 6, 0, 0, 0, 0, time.UTC)},
+		{Name: "Midsommardagen", Date: holidays.MidsommarDagen},
+		{Name: "Kronprinsessans födelsedag", Date: time.Date(y, time.July, 14, 0, 0, 0, 0, time.UTC)},
+		{Name: "Drottningens namnsdag", Date: time.Date(y, time.August, 8, 0, 0, 0, 0, time.UTC)},
+		{Name: "FN-dagen", Date: time.Date(y, time.October, 24, 0, 0, 0, 0, time.UTC)},
+		{Name: "Gustav Adolfsdagen", Date: time.Date(y, time.November, 6, 0, 0, 0, 0, time.UTC)},
+		{Name: "Fars dag", Date: farsDag},
+		{Name: "Nobeldagen", Date: time.Date(y, time.December, 10, 0, 0, 0, 0, time.UTC)},
+		{Name: "Drottningens födelsedag", Date: time.Date(y, time.December, 23, 0, 0, 0, 0, time.UTC)},
+		{Name: "Juldagen", Date: holidays.JulDagen},
+	}
+
+	// Riksdagsval is held the second sunday in september every fourth year
+	// since 2014
+	if y >= 2014 && (y-2014)%4 == 0 {
+		valDag, err := nthWeekdayOfMonth(y, time.September, time.Sunday, 2)
+
+		if err != nil {
+			return nil, err
+		}
+
+		flagDays = append(flagDays, Holiday{Name: "Dag för val till riksdagen", Date: valDag})
+	}
+
+	setDetails(flagDays, FlagDay)
+
+	sort.Stable(Holidays(flagDays))
+
+	return flagDays, nil
+}
+
+// Mors dag är sista söndagen i maj
+func calcMorsDag(y int) (morsDag time.Time, err error) {
+	return nthWeekdayOfMonth(y, time.May, time.Sunday, -1)
+}
+
+// Fars dag är andra söndagen i november
+func calcFarsDag(y int) (farsDag time.Time, err error) {
+	return nthWeekdayOfMonth(y, time.November, time.Sunday, 2)
+}
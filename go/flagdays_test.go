@@ -0,0 +1,45 @@
+package swedishholidays
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFlagDaysNationaldagenBefore2005(t *testing.T) {
+	for _, y := range []int{2000, 2004, 2005} {
+		flagDays, err := FlagDays(y)
+
+		if err != nil {
+			t.Fatalf("FlagDays(%v) returned error: %v", y, err)
+		}
+
+		found := false
+		for _, flagDay := range flagDays {
+			if flagDay.Key == "sverigesnationaldag" {
+				found = flagDay.Date.Equal(date(y, time.June, 6))
+			}
+		}
+
+		if !found {
+			t.Errorf("FlagDays(%v) = %v, want Sveriges nationaldag on june 6", y, flagDays)
+		}
+
+		if flagDays[0].Key != "nyarsdagen" {
+			t.Errorf("FlagDays(%v) starts with %v, want Nyårsdagen", y, flagDays[0].Name)
+		}
+	}
+}
+
+func TestCalendarFlagDaysBefore2005(t *testing.T) {
+	holidays, err := New(WithStatutory(), WithFlagDays()).Holidays(2000)
+
+	if err != nil {
+		t.Fatalf("Holidays(2000) returned error: %v", err)
+	}
+
+	for _, holiday := range holidays {
+		if holiday.Date.Year() != 2000 {
+			t.Errorf("Holidays(2000) contains %v on %v", holiday.Name, holiday.Date.Format(time.DateOnly))
+		}
+	}
+}
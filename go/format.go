@@ -0,0 +1,48 @@
+package swedishholidays
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// String lists the holidays in chronological order, one per line, e.g.
+// "Nyårsdagen: 2023-01-01 (söndag)".
+func (h SwedishHolidays) String() string {
+	parsed, err := h.parse(time.DateOnly)
+
+	if err != nil {
+		type plain SwedishHolidays
+		return fmt.Sprintf("%+v", plain(h))
+	}
+
+	return parsed.String()
+}
+
+// String lists the holidays in chronological order, one per line, e.g.
+// "Nyårsdagen: 2023-01-01 (söndag)".
+func (h SwedishHolidaysT) String() string {
+	var b strings.Builder
+
+	for i, holiday := range h.list() {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "%v: %v (%v)", holiday.Name, holiday.Date.Format(time.DateOnly), holiday.SwedishWeekday())
+	}
+
+	return b.String()
+}
+
+// FormatHolidays reformats every date in h, which must be yyyy-mm-dd, with the
+// given time layout, e.g. "2 January 2006".
+func FormatHolidays(h SwedishHolidays, layout string) (SwedishHolidays, error) {
+	parsed, err := h.parse(time.DateOnly)
+
+	if err != nil {
+		return SwedishHolidays{}, err
+	}
+
+	return parsed.format(layout), nil
+}
@@ -0,0 +1,3 @@
+module github.com/kottetall/swedish_holidays/go
+
+go 1.24
@@ -0,0 +1,58 @@
+package swedishholidays
+
+import (
+	"sort"
+	"time"
+)
+
+// HalfDay is a day that many workplaces treat as a half day, usually with the
+// afternoon off. Note describes why.
+type HalfDay struct {
+	Holiday
+	Note string
+}
+
+// HalfDays returns the conventional half days of the given year in
+// chronological order. They follow the common agreements, e.g. the one for
+// state employees, where the working hours are shortened on the day before
+// some holidays. Nothing about them is statutory, so check the agreement that
+// applies. Half days falling on a weekend are included as well.
+//
+// Nyårsafton is included since many agreements make it a half day, julafton
+// and midsommarafton usually are whole days off and aren't, see GetEves.
+func HalfDays(y int) ([]HalfDay, error) {
+	holidays, err := GetHolidaysT(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	halfDays := []Holiday{
+		{Name: "Trettondagsafton", Date: holidays.TrettondedagJul.AddDate(0, 0, -1)},
+		{Name: "Skärtorsdagen", Date: calcSkartorsdagen(holidays.PaskDagen)},
+		{Name: "Valborgsmässoafton", Date: time.Date(y, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		{Name: "Dagen före Kristi himmelsfärdsdag", Date: holidays.KristiHimmelsfardsdag.AddDate(0, 0, -1)},
+		{Name: "Allhelgonaafton", Date: holidays.AllaHelgonsDag.AddDate(0, 0, -1)},
+		{Name: "Nyårsafton", Date: time.Date(y, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	setDetails(halfDays, ShortenedDay)
+
+	sort.Stable(Holidays(halfDays))
+
+	notes := map[string]string{
+		"trettondagsafton":               "the day before trettondedag jul",
+		"skartorsdagen":                  "the day before långfredagen",
+		"valborgsmassoafton":             "the day before första maj",
+		"dagenforekristihimmelsfardsdag": "the day before Kristi himmelsfärdsdag",
+		"allhelgonaafton":                "the day before alla helgons dag",
+		"nyarsafton":                     "the day before nyårsdagen",
+	}
+
+	result := make([]HalfDay, len(halfDays))
+	for i, holiday := range halfDays {
+		result[i] = HalfDay{Holiday: holiday, Note: notes[holiday.Key]}
+	}
+
+	return result, nil
+}
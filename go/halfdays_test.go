@@ -0,0 +1,41 @@
+package swedishholidays
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHalfDays(t *testing.T) {
+	halfDays, err := HalfDays(2024)
+
+	if err != nil {
+		t.Fatalf("HalfDays(2024) returned error: %v", err)
+	}
+
+	var got []string
+	for _, halfDay := range halfDays {
+		got = append(got, halfDay.Date.Format(time.DateOnly)+" "+halfDay.Key)
+
+		if halfDay.Kind != ShortenedDay {
+			t.Errorf("%v has kind %v, want ShortenedDay", halfDay.Name, halfDay.Kind)
+		}
+
+		if halfDay.Note == "" {
+			t.Errorf("%v has no note", halfDay.Name)
+		}
+	}
+
+	want := []string{
+		"2024-01-05 trettondagsafton",
+		"2024-03-28 skartorsdagen",
+		"2024-04-30 valborgsmassoafton",
+		"2024-05-08 dagenforekristihimmelsfardsdag",
+		"2024-11-01 allhelgonaafton",
+		"2024-12-31 nyarsafton",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("HalfDays(2024) =\n%v\nwant\n%v", got, want)
+	}
+}
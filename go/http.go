@@ -0,0 +1,56 @@
+package swedishholidays
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Handler serves the holidays of a year as JSON, in the same shape as the
+// JSON encoding of SwedishHolidays. The year is taken from the year query
+// parameter, e.g. GET /holidays?year=2024, and defaults to the current year
+// in Stockholm.
+func Handler() http.Handler {
+	return http.HandlerFunc(serveHolidays)
+}
+
+func serveHolidays(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	year := time.Now().In(Stockholm).Year()
+
+	if param := r.URL.Query().Get("year"); param != "" {
+		parsed, err := strconv.Atoi(param)
+
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid year "+strconv.Quote(param))
+			return
+		}
+
+		year = parsed
+	}
+
+	holidays, err := GetHolidays(year)
+
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, holidays)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,70 @@
+package swedishholidays
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const icalDate = "20060102"
+
+// ToICal returns the holidays of the given year as an iCalendar (.ics)
+// document with one all-day event per holiday.
+//
+// The UIDs are derived from the date and the holiday, so importing the same
+// year twice updates the events instead of creating duplicates.
+func ToICal(y int) (string, error) {
+	var b strings.Builder
+
+	if err := WriteICal(&b, y, y); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// WriteICal streams the holidays of the years start to end, both included,
+// to w as one iCalendar document, see ToICal. The years are calculated one at
+// a time, so memory use doesn't grow with the range. If a year fails part of
+// the document may already have been written.
+func WriteICal(w io.Writer, start int, end int) error {
+	if start > end {
+		return fmt.Errorf("%w: %v is after %v", ErrInvalidRange, start, end)
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("BEGIN:VCALENDAR\r\n")
+	bw.WriteString("VERSION:2.0\r\n")
+	bw.WriteString("PRODID:-//kottetall//swedish_holidays//SV\r\n")
+	bw.WriteString("CALSCALE:GREGORIAN\r\n")
+
+	for y := start; y <= end; y++ {
+		holidays, err := holidaysOf(y)
+
+		if err != nil {
+			return err
+		}
+
+		for _, holiday := range holidays {
+			writeICalEvent(bw, holiday)
+		}
+	}
+
+	bw.WriteString("END:VCALENDAR\r\n")
+	return bw.Flush()
+}
+
+// writeICalEvent relies on bufio.Writer keeping the first write error, which
+// is then returned by Flush
+func writeICalEvent(w *bufio.Writer, h Holiday) {
+	start := h.Date.Format(icalDate)
+	fmt.Fprintf(w, "BEGIN:VEVENT\r\n")
+	fmt.Fprintf(w, "UID:%v-%v@swedish-holidays\r\n", start, h.Key)
+	fmt.Fprintf(w, "DTSTAMP:%vT000000Z\r\n", start)
+	fmt.Fprintf(w, "DTSTART;VALUE=DATE:%v\r\n", start)
+	fmt.Fprintf(w, "DTEND;VALUE=DATE:%v\r\n", h.Date.AddDate(0, 0, 1).Format(icalDate))
+	fmt.Fprintf(w, "SUMMARY:%v\r\n", h.Name)
+	fmt.Fprintf(w, "TRANSP:TRANSPARENT\r\n")
+	fmt.Fprintf(w, "END:VEVENT\r\n")
+}
@@ -0,0 +1,43 @@
+package swedishholidays
+
+import (
+	"fmt"
+	"iter"
+	"time"
+)
+
+// Range iterates over the holidays from start to end, both days included, in
+// chronological order, like HolidaysInRange but without building the whole
+// slice. The holidays are calculated one year at a time.
+//
+// On failure a single zero Holiday is yielded together with the error and the
+// iteration stops.
+func Range(start time.Time, end time.Time) iter.Seq2[Holiday, error] {
+	return func(yield func(Holiday, error) bool) {
+		from, to := dateOf(start), dateOf(end)
+
+		if from.After(to) {
+			yield(Holiday{}, fmt.Errorf("%w: %v is after %v", ErrInvalidRange, from.Format(time.DateOnly), to.Format(time.DateOnly)))
+			return
+		}
+
+		for y := from.Year(); y <= to.Year(); y++ {
+			holidays, err := holidaysOf(y)
+
+			if err != nil {
+				yield(Holiday{}, err)
+				return
+			}
+
+			for _, holiday := range holidays {
+				if holiday.Date.Before(from) || holiday.Date.After(to) {
+					continue
+				}
+
+				if !yield(holiday, nil) {
+					return
+				}
+			}
+		}
+	}
+}
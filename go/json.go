@@ -0,0 +1,137 @@
+package swedishholidays
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// MarshalJSON encodes the holidays as an object keyed by holiday with
+// yyyy-mm-dd values, the same shape as SwedishHolidays.
+func (h SwedishHolidaysT) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.format(time.DateOnly))
+}
+
+// UnmarshalJSON decodes the format produced by MarshalJSON.
+func (h *SwedishHolidaysT) UnmarshalJSON(data []byte) error {
+	var s SwedishHolidays
+
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := s.parse(time.DateOnly)
+
+	if err != nil {
+		return err
+	}
+
+	*h = parsed
+	return nil
+}
+
+func (h SwedishHolidaysT) format(layout string) SwedishHolidays {
+	return SwedishHolidays{
+		NyarsDagen:            h.NyarsDagen.Format(layout),
+		TrettondedagJul:       h.TrettondedagJul.Format(layout),
+		Langfredagen:          h.Langfredagen.Format(layout),
+		PaskDagen:             h.PaskDagen.Format(layout),
+		AnnandagPask:          h.AnnandagPask.Format(layout),
+		ForstaMaj:             h.ForstaMaj.Format(layout),
+		KristiHimmelsfardsdag: h.KristiHimmelsfardsdag.Format(layout),
+		PingstDagen:           h.PingstDagen.Format(layout),
+		AnnandagPingst:        formatOptional(h.AnnandagPingst, layout),
+		NationalDagen:         formatOptional(h.NationalDagen, layout),
+		MidsommarDagen:        h.MidsommarDagen.Format(layout),
+		AllaHelgonsDag:        h.AllaHelgonsDag.Format(layout),
+		JulDagen:              h.JulDagen.Format(layout),
+		AnnandagJul:           h.AnnandagJul.Format(layout),
+	}
+}
+
+func (h SwedishHolidays) parse(layout string) (SwedishHolidaysT, error) {
+	var parsed SwedishHolidaysT
+	fields := []struct {
+		value    string
+		target   *time.Time
+		optional bool
+	}{
+		{h.NyarsDagen, &parsed.NyarsDagen, false},
+		{h.TrettondedagJul, &parsed.TrettondedagJul, false},
+		{h.Langfredagen, &parsed.Langfredagen, false},
+		{h.PaskDagen, &parsed.PaskDagen, false},
+		{h.AnnandagPask, &parsed.AnnandagPask, false},
+		{h.ForstaMaj, &parsed.ForstaMaj, false},
+		{h.KristiHimmelsfardsdag, &parsed.KristiHimmelsfardsdag, false},
+		{h.PingstDagen, &parsed.PingstDagen, false},
+		{h.AnnandagPingst, &parsed.AnnandagPingst, true},
+		{h.NationalDagen, &parsed.NationalDagen, true},
+		{h.MidsommarDagen, &parsed.MidsommarDagen, false},
+		{h.AllaHelgonsDag, &parsed.AllaHelgonsDag, false},
+		{h.JulDagen, &parsed.JulDagen, false},
+		{h.AnnandagJul, &parsed.AnnandagJul, false},
+	}
+
+	for _, field := range fields {
+		// Nationaldagen and annandag pingst are only set for some years
+		if field.optional && field.value == "" {
+			continue
+		}
+
+		date, err := time.Parse(layout, field.value)
+
+		if err != nil {
+			return SwedishHolidaysT{}, err
+		}
+
+		*field.target = date
+	}
+
+	return parsed, nil
+}
+
+// formatOptional formats the zero time as an empty string
+func formatOptional(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(layout)
+}
+
+// HolidayRecord is a flat, serialization friendly representation of a
+// Holiday with the date as yyyy-mm-dd and the weekday in English, e.g.
+// "Monday".
+type HolidayRecord struct {
+	Key         string `json:"key"`
+	SwedishName string `json:"swedish_name"`
+	EnglishName string `json:"english_name"`
+	Date        string `json:"date"`
+	Weekday     string `json:"weekday"`
+}
+
+// HolidayRecords returns the holidays of the given year as HolidayRecords
+// in chronological order.
+func HolidayRecords(y int) ([]HolidayRecord, error) {
+	holidays, err := holidaysOf(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]HolidayRecord, len(holidays))
+	for i, holiday := range holidays {
+		records[i] = holiday.record()
+	}
+
+	return records, nil
+}
+
+func (h Holiday) record() HolidayRecord {
+	return HolidayRecord{
+		Key:         h.Key,
+		SwedishName: h.Name,
+		EnglishName: h.EnglishName,
+		Date:        h.Date.Format(time.DateOnly),
+		Weekday:     h.Weekday().String(),
+	}
+}
@@ -0,0 +1,56 @@
+package swedishholidays
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSwedishHolidaysTJSONRoundTrip(t *testing.T) {
+	// 2000 has annandag pingst but no nationaldagen
+	for _, y := range []int{2000, 2023} {
+		holidays, err := GetHolidaysT(y)
+
+		if err != nil {
+			t.Fatalf("GetHolidaysT(%v) returned error: %v", y, err)
+		}
+
+		data, err := json.Marshal(holidays)
+
+		if err != nil {
+			t.Fatalf("json.Marshal returned error: %v", err)
+		}
+
+		var decoded SwedishHolidaysT
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned error: %v", data, err)
+		}
+
+		if decoded != holidays {
+			t.Errorf("round trip of %v gave\n%v\nwant\n%v", y, decoded, holidays)
+		}
+	}
+}
+
+func TestSwedishHolidaysTMarshalJSON(t *testing.T) {
+	holidays, err := GetHolidaysT(2023)
+
+	if err != nil {
+		t.Fatalf("GetHolidaysT(2023) returned error: %v", err)
+	}
+
+	data, err := json.Marshal(holidays)
+
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	want := `{"nyarsDagen":"2023-01-01","trettondedagJul":"2023-01-06",`
+	if !strings.HasPrefix(string(data), want) {
+		t.Errorf("json.Marshal = %s, want it to start with %s", data, want)
+	}
+
+	if strings.Contains(string(data), "annandagPingst") {
+		t.Errorf("json.Marshal = %s, want no annandagPingst after 2004", data)
+	}
+}
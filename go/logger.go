@@ -0,0 +1,27 @@
+package swedishholidays
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+var logger atomic.Pointer[slog.Logger]
+
+func init() {
+	logger.Store(slog.New(slog.DiscardHandler))
+}
+
+// SetLogger sets the logger used for diagnostics, e.g. which holidays were
+// calculated for a year. Nothing is logged by default. A nil logger turns
+// logging off again.
+func SetLogger(l *slog.Logger) {
+	if l == nil {
+		l = slog.New(slog.DiscardHandler)
+	}
+
+	logger.Store(l)
+}
+
+func log() *slog.Logger {
+	return logger.Load()
+}
@@ -0,0 +1,370 @@
+package swedishholidays
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+)
+
+// Holiday is a single holiday with its Swedish and English name.
+type Holiday struct {
+	// Key is a stable ascii identifier, e.g. "midsommardagen"
+	Key         string
+	Name        string
+	EnglishName string
+	Date        time.Time
+	Kind        Kind
+	// Reference is the legal basis of the holiday, e.g. "Lag (1989:253) om
+	// allmänna helgdagar, 1 §". It's empty for days without one.
+	Reference string
+}
+
+// Kind tells which list a holiday comes from.
+type Kind int
+
+const (
+	// Statutory is an allmän helgdag, see HolidaysOf
+	Statutory Kind = iota
+	// Eve is one of the aftnar, see GetEves
+	Eve
+	// FlagDay is an allmän flaggdag, see FlagDays
+	FlagDay
+	// ChurchDay is a day of the church year, see ChurchDays
+	ChurchDay
+	// ShortenedDay is a day with shortened working hours, see HalfDays
+	ShortenedDay
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Statutory:
+		return "Statutory"
+	case Eve:
+		return "Eve"
+	case FlagDay:
+		return "FlagDay"
+	case ChurchDay:
+		return "ChurchDay"
+	case ShortenedDay:
+		return "ShortenedDay"
+	}
+
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Equal reports whether h and other have the same name and fall on the same
+// calendar day.
+func (h Holiday) Equal(other Holiday) bool {
+	return h.Name == other.Name && isSameDay(h.Date, other.Date)
+}
+
+// Holidays implements sort.Interface, sorting holidays by date.
+type Holidays []Holiday
+
+func (hs Holidays) Len() int           { return len(hs) }
+func (hs Holidays) Less(i, j int) bool { return hs[i].Date.Before(hs[j].Date) }
+func (hs Holidays) Swap(i, j int)      { hs[i], hs[j] = hs[j], hs[i] }
+
+// Weekday returns the weekday the holiday falls on.
+func (h Holiday) Weekday() time.Weekday {
+	return h.Date.Weekday()
+}
+
+// SwedishWeekday returns the Swedish name of the weekday the holiday falls
+// on, e.g. "lördag".
+func (h Holiday) SwedishWeekday() string {
+	return SwedishWeekday(h.Weekday())
+}
+
+// HolidaysOf returns all holidays for the given year in chronological order.
+// The order is by date, not by the fields of SwedishHolidaysT, e.g. for 2011
+// pingstdagen (june 12) comes after nationaldagen.
+func HolidaysOf(y int) ([]Holiday, error) {
+	holidays, err := holidaysOf(y)
+	return slices.Clone(holidays), err
+}
+
+// GetHolidaysForYears returns the holidays of several years keyed by year. It
+// stops at the first year that fails, e.g. with ErrYearOutOfRange.
+func GetHolidaysForYears(years []int) (map[int][]Holiday, error) {
+	byYear := make(map[int][]Holiday, len(years))
+
+	for _, y := range years {
+		holidays, err := HolidaysOf(y)
+
+		if err != nil {
+			return nil, err
+		}
+
+		byYear[y] = holidays
+	}
+
+	return byYear, nil
+}
+
+// holidaysOf is HolidaysOf without the copy, the returned slice must not be
+// modified
+func holidaysOf(y int) ([]Holiday, error) {
+	cached, err := cachedHolidays(y)
+	return cached.list, err
+}
+
+// HolidayCount returns the number of holidays in the given year. Which
+// holidays are counted depends on the year, before 2005 annandag pingst is
+// counted instead of nationaldagen.
+func HolidayCount(y int) (int, error) {
+	holidays, err := holidaysOf(y)
+	return len(holidays), err
+}
+
+// HolidaysMap returns the holidays of the given year keyed by their Swedish
+// name, e.g. "Midsommardagen". Use HolidaysOf when the order matters.
+func HolidaysMap(y int) (map[string]time.Time, error) {
+	holidays, err := holidaysOf(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]time.Time, len(holidays))
+	for _, holiday := range holidays {
+		byName[holiday.Name] = holiday.Date
+	}
+
+	return byName, nil
+}
+
+// ISOWeek is an ISO 8601 week. Year is the ISO year the week belongs to,
+// which differs from the calendar year for some days around new year.
+type ISOWeek struct {
+	Year int
+	Week int
+}
+
+// HolidayWeeks returns the ISO 8601 week of each holiday of the given year,
+// keyed by Swedish name.
+//
+// The ISO week of a holiday in early january can belong to the previous year,
+// e.g. nyårsdagen 2027 is in week 53 of 2026.
+func HolidayWeeks(y int) (map[string]ISOWeek, error) {
+	holidays, err := holidaysOf(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	weeks := make(map[string]ISOWeek, len(holidays))
+	for _, holiday := range holidays {
+		year, week := holiday.Date.ISOWeek()
+		weeks[holiday.Name] = ISOWeek{Year: year, Week: week}
+	}
+
+	return weeks, nil
+}
+
+// HolidayByKey returns the date of the holiday with the given key, e.g.
+// "paskdagen", for the given year. See Holiday.Key.
+func HolidayByKey(y int, key string) (time.Time, error) {
+	holidays, err := holidaysOf(y)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, holiday := range holidays {
+		if holiday.Key == key {
+			return holiday.Date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q in %v", ErrUnknownHoliday, key, y)
+}
+
+// IsHoliday reports whether the given date is a Swedish public holiday.
+// Only the year, month and day of d are compared, the time of day is ignored.
+func IsHoliday(d time.Time) (bool, error) {
+	_, found, err := HolidayName(d)
+	return found, err
+}
+
+// HolidayName returns the Swedish name of the holiday on the given date, e.g.
+// "Midsommardagen". The boolean is false if the date isn't a holiday.
+func HolidayName(d time.Time) (name string, found bool, err error) {
+	holiday, found, err := holidayOn(d)
+	return holiday.Name, found, err
+}
+
+// HolidayByDateString works like HolidayName for a yyyy-mm-dd date, e.g. from
+// a CSV file, but returns the whole Holiday. Malformed dates give an error.
+func HolidayByDateString(date string) (Holiday, bool, error) {
+	d, err := time.Parse(time.DateOnly, date)
+
+	if err != nil {
+		return Holiday{}, false, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+
+	return holidayOn(d)
+}
+
+func holidayOn(d time.Time) (Holiday, bool, error) {
+	holidays, err := holidaysOf(d.Year())
+
+	if err != nil {
+		return Holiday{}, false, err
+	}
+
+	for _, holiday := range holidays {
+		if isSameDay(d, holiday.Date) {
+			return holiday, true, nil
+		}
+	}
+
+	return Holiday{}, false, nil
+}
+
+// NextHoliday returns the first holiday strictly after the given date, looking
+// into the next year if needed.
+func NextHoliday(from time.Time) (Holiday, error) {
+	day := dateOf(from)
+
+	for _, y := range []int{day.Year(), day.Year() + 1} {
+		holidays, err := holidaysOf(y)
+
+		if err != nil {
+			return Holiday{}, err
+		}
+
+		for _, holiday := range holidays {
+			if holiday.Date.After(day) {
+				return holiday, nil
+			}
+		}
+	}
+
+	return Holiday{}, fmt.Errorf("%w: no holiday after %v", ErrNoDateFound, day.Format(time.DateOnly))
+}
+
+// DaysUntilNextHoliday returns the number of calendar days from the given
+// date until the next holiday, together with the holiday itself.
+func DaysUntilNextHoliday(from time.Time) (int, Holiday, error) {
+	next, err := NextHoliday(from)
+
+	if err != nil {
+		return 0, Holiday{}, err
+	}
+
+	return daysBetween(from, next.Date), next, nil
+}
+
+// PreviousHoliday returns the last holiday strictly before the given date,
+// looking into the previous year if needed. If from is itself a holiday the
+// one before it is returned.
+func PreviousHoliday(from time.Time) (Holiday, error) {
+	day := dateOf(from)
+
+	for _, y := range []int{day.Year(), day.Year() - 1} {
+		holidays, err := holidaysOf(y)
+
+		if err != nil {
+			return Holiday{}, err
+		}
+
+		for i := len(holidays) - 1; i >= 0; i-- {
+			if holidays[i].Date.Before(day) {
+				return holidays[i], nil
+			}
+		}
+	}
+
+	return Holiday{}, fmt.Errorf("%w: no holiday before %v", ErrNoDateFound, day.Format(time.DateOnly))
+}
+
+// HolidaysInRange returns all holidays from start to end, both days included,
+// in chronological order. The range may span several years.
+func HolidaysInRange(start time.Time, end time.Time) ([]Holiday, error) {
+	return HolidaysInRangeCtx(context.Background(), start, end)
+}
+
+// HolidaysInRangeCtx works like HolidaysInRange but stops with ctx.Err() once
+// ctx is cancelled. The context is checked once per year.
+func HolidaysInRangeCtx(ctx context.Context, start time.Time, end time.Time) ([]Holiday, error) {
+	from, to := dateOf(start), dateOf(end)
+
+	if from.After(to) {
+		return nil, fmt.Errorf("%w: %v is after %v", ErrInvalidRange, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	}
+
+	var inRange []Holiday
+	for y := from.Year(); y <= to.Year(); y++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		holidays, err := holidaysOf(y)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, holiday := range holidays {
+			if !holiday.Date.Before(from) && !holiday.Date.After(to) {
+				inRange = append(inRange, holiday)
+			}
+		}
+	}
+
+	return inRange, nil
+}
+
+func (h SwedishHolidaysT) list() []Holiday {
+	holidays := []Holiday{
+		{Name: "Nyårsdagen", Date: h.NyarsDagen},
+		{Name: "Trettondedag jul", Date: h.TrettondedagJul},
+		{Name: "Långfredagen", Date: h.Langfredagen},
+		{Name: "Påskdagen", Date: h.PaskDagen},
+		{Name: "Annandag påsk", Date: h.AnnandagPask},
+		{Name: "Första maj", Date: h.ForstaMaj},
+		{Name: "Kristi himmelsfärdsdag", Date: h.KristiHimmelsfardsdag},
+		{Name: "Pingstdagen", Date: h.PingstDagen},
+		{Name: "Annandag pingst", Date: h.AnnandagPingst},
+		{Name: "Nationaldagen", Date: h.NationalDagen},
+		{Name: "Midsommardagen", Date: h.MidsommarDagen},
+		{Name: "Alla helgons dag", Date: h.AllaHelgonsDag},
+		{Name: "Juldagen", Date: h.JulDagen},
+		{Name: "Annandag jul", Date: h.AnnandagJul},
+	}
+
+	// Either nationaldagen or annandag pingst is unset depending on the year
+	holidays = slices.DeleteFunc(holidays, func(holiday Holiday) bool {
+		return holiday.Date.IsZero()
+	})
+
+	setDetails(holidays, Statutory)
+
+	// Kristi himmelsfärdsdag can fall before första maj and pingstdagen after
+	// nationaldagen
+	sort.Stable(Holidays(holidays))
+
+	return holidays
+}
+
+// dateOf returns the calendar day of t, in t's own location, as midnight
+// UTC, which is how all holiday dates are represented. Since UTC has no DST
+// every day between two such dates is exactly 24 hours.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween counts the calendar days from a to b. Both are truncated to
+// their calendar day first, so a DST switch in between doesn't matter.
+func daysBetween(a time.Time, b time.Time) int {
+	return int(dateOf(b).Sub(dateOf(a)).Hours() / 24)
+}
+
+func isSameDay(a time.Time, b time.Time) bool {
+	aYear, aMonth, aDay := a.Date()
+	bYear, bMonth, bDay := b.Date()
+	return aYear == bYear && aMonth == bMonth && aDay == bDay
+}
@@ -0,0 +1,135 @@
+package swedishholidays
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDaysUntilNextHolidayAcrossDST(t *testing.T) {
+	tests := []struct {
+		from time.Time
+		days int
+		key  string
+	}{
+		// Less than 20*24 hours before långfredagen since the clocks go
+		// forward on march 30
+		{time.Date(2025, time.March, 29, 23, 30, 0, 0, Stockholm), 20, "langfredagen"},
+		{time.Date(2024, time.March, 30, 12, 0, 0, 0, Stockholm), 1, "paskdagen"},
+		{time.Date(2024, time.March, 31, 23, 0, 0, 0, Stockholm), 1, "annandagpask"},
+	}
+
+	for _, tt := range tests {
+		days, holiday, err := DaysUntilNextHoliday(tt.from)
+
+		if err != nil {
+			t.Fatalf("DaysUntilNextHoliday(%v) returned error: %v", tt.from, err)
+		}
+
+		if days != tt.days || holiday.Key != tt.key {
+			t.Errorf("DaysUntilNextHoliday(%v) = %v, %v, want %v, %v", tt.from, days, holiday.Key, tt.days, tt.key)
+		}
+	}
+}
+
+// The last sunday of march 2024 only has 23 hours in Stockholm
+func TestDaysBetweenAcrossDST(t *testing.T) {
+	start := time.Date(2024, time.March, 30, 0, 0, 0, 0, Stockholm)
+	end := time.Date(2024, time.April, 1, 0, 0, 0, 0, Stockholm)
+
+	if got := daysBetween(start, end); got != 2 {
+		t.Errorf("daysBetween(%v, %v) = %v, want 2", start, end, got)
+	}
+
+	// Mon 25 march-fri 5 april without långfredagen and annandag påsk
+	start = time.Date(2024, time.March, 25, 8, 0, 0, 0, Stockholm)
+	end = time.Date(2024, time.April, 6, 8, 0, 0, 0, Stockholm)
+	got, err := WorkingDaysBetweenIn(start, end, Stockholm)
+
+	if err != nil {
+		t.Fatalf("WorkingDaysBetweenIn(%v, %v) returned error: %v", start, end, err)
+	}
+
+	if got != 8 {
+		t.Errorf("WorkingDaysBetweenIn(%v, %v) = %v, want 8", start, end, got)
+	}
+}
+
+// IsHoliday used to take 184 allocations per call when it went through the
+// yyyy-mm-dd strings, with the cached []Holiday it takes none
+func TestIsHolidayAllocs(t *testing.T) {
+	d := date(2024, time.June, 22)
+	allocs := testing.AllocsPerRun(100, func() {
+		IsHoliday(d)
+	})
+
+	if allocs != 0 {
+		t.Errorf("IsHoliday allocates %v times per call, want 0", allocs)
+	}
+}
+
+func BenchmarkIsHoliday(b *testing.B) {
+	d := date(2024, time.June, 22)
+
+	for b.Loop() {
+		IsHoliday(d)
+	}
+}
+
+func TestHolidaysOfOrder(t *testing.T) {
+	holidays, err := HolidaysOf(2023)
+
+	if err != nil {
+		t.Fatalf("HolidaysOf(2023) returned error: %v", err)
+	}
+
+	var got []string
+	for _, holiday := range holidays {
+		got = append(got, holiday.Date.Format(time.DateOnly)+" "+holiday.Name)
+	}
+
+	want := []string{
+		"2023-01-01 Nyårsdagen",
+		"2023-01-06 Trettondedag jul",
+		"2023-04-07 Långfredagen",
+		"2023-04-09 Påskdagen",
+		"2023-04-10 Annandag påsk",
+		"2023-05-01 Första maj",
+		"2023-05-18 Kristi himmelsfärdsdag",
+		"2023-05-28 Pingstdagen",
+		"2023-06-06 Nationaldagen",
+		"2023-06-24 Midsommardagen",
+		"2023-11-04 Alla helgons dag",
+		"2023-12-25 Juldagen",
+		"2023-12-26 Annandag jul",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("HolidaysOf(2023) =\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestHolidayWeeks(t *testing.T) {
+	tests := []struct {
+		year int
+		name string
+		want ISOWeek
+	}{
+		{2027, "Nyårsdagen", ISOWeek{Year: 2026, Week: 53}},
+		{2023, "Nyårsdagen", ISOWeek{Year: 2022, Week: 52}},
+		{2024, "Nyårsdagen", ISOWeek{Year: 2024, Week: 1}},
+		{2024, "Midsommardagen", ISOWeek{Year: 2024, Week: 25}},
+	}
+
+	for _, tt := range tests {
+		weeks, err := HolidayWeeks(tt.year)
+
+		if err != nil {
+			t.Fatalf("HolidayWeeks(%v) returned error: %v", tt.year, err)
+		}
+
+		if got := weeks[tt.name]; got != tt.want {
+			t.Errorf("HolidayWeeks(%v)[%q] = %+v, want %+v", tt.year, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package swedishholidays
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// MergeHolidays merges several holiday lists, e.g. HolidaysOf, GetEves and
+// FlagDays, into one list sorted by date. Days from different lists on the
+// same date, e.g. the flag day on nationaldagen, are only kept once. A
+// statutory holiday wins over other days on the same date, otherwise the first
+// one given is kept. Different statutory holidays on the same date, like
+// första maj and Kristi himmelsfärdsdag in 2008, are all kept.
+func MergeHolidays(lists ...[]Holiday) []Holiday {
+	var merged []Holiday
+	byDay := make(map[time.Time][]int)
+
+	for _, list := range lists {
+		for _, holiday := range list {
+			day := dateOf(holiday.Date)
+			indexes := byDay[day]
+
+			switch {
+			case len(indexes) == 0:
+			case !isStatutory(holiday):
+				continue
+			case !isStatutory(merged[indexes[0]]):
+				// Only one non-statutory day is kept per date
+				merged[indexes[0]] = holiday
+				continue
+			case slices.ContainsFunc(indexes, func(i int) bool { return merged[i].Key == holiday.Key }):
+				continue
+			}
+
+			byDay[day] = append(indexes, len(merged))
+			merged = append(merged, holiday)
+		}
+	}
+
+	sort.Stable(Holidays(merged))
+	return merged
+}
+
+// FilterByKind returns the holidays of hs that have one of the given kinds,
+// keeping their order.
+func FilterByKind(hs []Holiday, kinds ...Kind) []Holiday {
+	var filtered []Holiday
+
+	for _, holiday := range hs {
+		if slices.Contains(kinds, holiday.Kind) {
+			filtered = append(filtered, holiday)
+		}
+	}
+
+	return filtered
+}
+
+func isStatutory(h Holiday) bool {
+	return h.Kind == Statutory
+}
@@ -0,0 +1,58 @@
+package swedishholidays
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func holidayKeys(holidays []Holiday) []string {
+	var keys []string
+	for _, holiday := range holidays {
+		keys = append(keys, holiday.Date.Format(time.DateOnly)+" "+holiday.Key)
+	}
+
+	return keys
+}
+
+func TestMergeHolidaysKeepsStatutoryOnSameDay(t *testing.T) {
+	// Första maj and Kristi himmelsfärdsdag both fall on may 1 2008
+	holidays, err := HolidaysOf(2008)
+
+	if err != nil {
+		t.Fatalf("HolidaysOf(2008) returned error: %v", err)
+	}
+
+	flagDays, err := FlagDays(2008)
+
+	if err != nil {
+		t.Fatalf("FlagDays(2008) returned error: %v", err)
+	}
+
+	merged := MergeHolidays(holidays, holidays, flagDays)
+	got := FilterByKind(merged, Statutory)
+
+	if !slices.Equal(holidayKeys(got), holidayKeys(holidays)) {
+		t.Errorf("MergeHolidays kept the statutory holidays\n%v\nwant\n%v", holidayKeys(got), holidayKeys(holidays))
+	}
+
+	for _, holiday := range merged {
+		if holiday.Key == "forstamaj" && holiday.Kind != Statutory {
+			t.Errorf("MergeHolidays kept the %v första maj, want the statutory one", holiday.Kind)
+		}
+	}
+}
+
+func TestMergeHolidaysStatutoryWins(t *testing.T) {
+	nationalDagen := Holiday{Key: "nationaldagen", Name: "Nationaldagen", Date: date(2024, time.June, 6), Kind: Statutory}
+	flagDay := Holiday{Key: "sverigesnationaldag", Name: "Sveriges nationaldag", Date: date(2024, time.June, 6), Kind: FlagDay}
+	eve := Holiday{Key: "julafton", Name: "Julafton", Date: date(2024, time.December, 24), Kind: Eve}
+	churchDay := Holiday{Key: "julafton", Name: "Julafton", Date: date(2024, time.December, 24), Kind: ChurchDay}
+
+	got := MergeHolidays([]Holiday{flagDay, eve}, []Holiday{nationalDagen, churchDay})
+	want := []Holiday{nationalDagen, eve}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeHolidays = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,139 @@
+package swedishholidays
+
+import (
+	"strings"
+	"time"
+)
+
+var swedishWeekdays = [...]string{
+	time.Sunday:    "söndag",
+	time.Monday:    "måndag",
+	time.Tuesday:   "tisdag",
+	time.Wednesday: "onsdag",
+	time.Thursday:  "torsdag",
+	time.Friday:    "fredag",
+	time.Saturday:  "lördag",
+}
+
+var swedishMonths = [...]string{
+	time.January:   "januari",
+	time.February:  "februari",
+	time.March:     "mars",
+	time.April:     "april",
+	time.May:       "maj",
+	time.June:      "juni",
+	time.July:      "juli",
+	time.August:    "augusti",
+	time.September: "september",
+	time.October:   "oktober",
+	time.November:  "november",
+	time.December:  "december",
+}
+
+// SwedishWeekday returns the Swedish name of the weekday, e.g. "måndag".
+func SwedishWeekday(d time.Weekday) string {
+	if d < time.Sunday || d > time.Saturday {
+		return ""
+	}
+
+	return swedishWeekdays[d]
+}
+
+// SwedishMonth returns the Swedish name of the month, e.g. "juni".
+func SwedishMonth(m time.Month) string {
+	if m < time.January || m > time.December {
+		return ""
+	}
+
+	return swedishMonths[m]
+}
+
+var englishNames = map[string]string{
+	"Nyårsdagen":             "New Year's Day",
+	"Trettondedag jul":       "Epiphany",
+	"Långfredagen":           "Good Friday",
+	"Påskdagen":              "Easter Sunday",
+	"Annandag påsk":          "Easter Monday",
+	"Kristi himmelsfärdsdag": "Ascension Day",
+	"Pingstdagen":            "Whit Sunday",
+	"Annandag pingst":        "Whit Monday",
+	"Nationaldagen":          "National Day of Sweden",
+	"Midsommardagen":         "Midsummer Day",
+	"Alla helgons dag":       "All Saints' Day",
+	"Juldagen":               "Christmas Day",
+	"Annandag jul":           "Boxing Day",
+
+	"Påskafton":      "Easter Eve",
+	"Midsommarafton": "Midsummer Eve",
+	"Julafton":       "Christmas Eve",
+	"Nyårsafton":     "New Year's Eve",
+
+	"Trettondagsafton":                  "Twelfth Night",
+	"Valborgsmässoafton":                "Walpurgis Night",
+	"Dagen före Kristi himmelsfärdsdag": "Day before Ascension Day",
+	"Allhelgonaafton":                   "All Saints' Eve",
+
+	"Fettisdagen":   "Shrove Tuesday",
+	"Askonsdagen":   "Ash Wednesday",
+	"Palmsöndagen":  "Palm Sunday",
+	"Skärtorsdagen": "Maundy Thursday",
+
+	"Konungens namnsdag":         "The King's Name Day",
+	"Kronprinsessans namnsdag":   "The Crown Princess's Name Day",
+	"Konungens födelsedag":       "The King's Birthday",
+	"Första maj":                 "May Day",
+	"Mors dag":                   "Mother's Day",
+	"Sveriges nationaldag":       "National Day of Sweden",
+	"Kronprinsessans födelsedag": "The Crown Princess's Birthday",
+	"Drottningens namnsdag":      "The Queen's Name Day",
+	"FN-dagen":                   "United Nations Day",
+	"Gustav Adolfsdagen":         "Gustavus Adolphus Day",
+	"Fars dag":                   "Father's Day",
+	"Nobeldagen":                 "Nobel Day",
+	"Drottningens födelsedag":    "The Queen's Birthday",
+	"Dag för val till riksdagen": "General Election Day",
+}
+
+// setDetails fills in the kind, English name and key of holidays which only
+// have their Swedish name and date set
+func setDetails(holidays []Holiday, kind Kind) {
+	for i := range holidays {
+		holidays[i].Kind = kind
+		holidays[i].EnglishName = EnglishName(holidays[i].Name)
+		holidays[i].Key = holidayKey(holidays[i].Name)
+		holidays[i].Reference = reference(holidays[i].Key, kind)
+	}
+}
+
+// The legal basis of the statutory holidays and the flag days
+const (
+	helgdagarReference  = "Lag (1989:253) om allmänna helgdagar, 1 §"
+	flaggdagarReference = "Förordning (1982:270) om allmänna flaggdagar, 1 §"
+)
+
+// reference returns the legal basis of a holiday. The eves, the church days
+// and Mors dag and Fars dag have none.
+func reference(key string, kind Kind) string {
+	switch {
+	case kind == Statutory:
+		return helgdagarReference
+	case kind == FlagDay && key != "morsdag" && key != "farsdag":
+		return flaggdagarReference
+	default:
+		return ""
+	}
+}
+
+// holidayKey turns a holiday name into a stable ascii identifier, e.g.
+// "Alla helgons dag" -> "allahelgonsdag"
+func holidayKey(name string) string {
+	return keyReplacer.Replace(strings.ToLower(name))
+}
+
+var keyReplacer = strings.NewReplacer(" ", "", "-", "", "å", "a", "ä", "a", "ö", "o")
+
+// EnglishName translates the Swedish name of a holiday, e.g. "Långfredagen"
+// -> "Good Friday". Unknown names are returned as an empty string.
+func EnglishName(swedishName string) string {
+	return englishNames[swedishName]
+}
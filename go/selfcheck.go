@@ -0,0 +1,51 @@
+package swedishholidays
+
+import (
+	"fmt"
+	"time"
+)
+
+// knownPaskDagar are published dates of påskdagen, including the extremes
+// march 22 and april 25 and the years that need special cases in the Gauss
+// method.
+var knownPaskDagar = []string{
+	"1583-04-10",
+	"1818-03-22",
+	"1886-04-25",
+	"1943-04-25",
+	"1954-04-18",
+	"1981-04-19",
+	"2000-04-23",
+	"2008-03-23",
+	"2011-04-24",
+	"2019-04-21",
+	"2024-03-31",
+	"2025-04-20",
+	"2038-04-25",
+	"2285-03-22",
+}
+
+// SelfCheck verifies the calculation of påskdagen, which all movable holidays
+// depend on, against a set of known dates. It returns an error describing the
+// first mismatch.
+func SelfCheck() error {
+	for _, known := range knownPaskDagar {
+		want, err := time.Parse(time.DateOnly, known)
+
+		if err != nil {
+			return err
+		}
+
+		got, err := calcPaskDagenT(want.Year())
+
+		if err != nil {
+			return err
+		}
+
+		if !got.Equal(want) {
+			return fmt.Errorf("self check failed: påskdagen %v calculated as %v, want %v", want.Year(), got.Format(time.DateOnly), known)
+		}
+	}
+
+	return nil
+}
@@ -1,208 +1,318 @@
-package main
+package swedishholidays
 
 import (
 	"errors"
 	"fmt"
-	"os"
+	"sync"
 	"time"
 )
 
-func main() {
-	testYear := 2023
-	fmt.Println(getHolidays(testYear))
+// SwedishHolidays holds the holidays of a year as yyyy-mm-dd strings.
+//
+// Nationaldagen became a helgdag in 2005, replacing annandag pingst. For years
+// before 2005 NationalDagen is empty, from 2005 AnnandagPingst is empty.
+type SwedishHolidays struct {
+	NyarsDagen            string `json:"nyarsDagen"`
+	TrettondedagJul       string `json:"trettondedagJul"`
+	Langfredagen          string `json:"langfredagen"`
+	PaskDagen             string `json:"paskDagen"`
+	AnnandagPask          string `json:"annandagPask"`
+	ForstaMaj             string `json:"forstaMaj"`
+	KristiHimmelsfardsdag string `json:"kristiHimmelsfardsdag"`
+	PingstDagen           string `json:"pingstDagen"`
+	AnnandagPingst        string `json:"annandagPingst,omitempty"`
+	NationalDagen         string `json:"nationalDagen,omitempty"`
+	MidsommarDagen        string `json:"midsommarDagen"`
+	AllaHelgonsDag        string `json:"allaHelgonsDag"`
+	JulDagen              string `json:"julDagen"`
+	AnnandagJul           string `json:"annandagJul"`
 }
 
-type swedishHolidays struct {
-	nyarsDagen            string
-	trettondedagJul       string
-	langfredagen          string
-	paskDagen             string
-	annandagPask          string
-	kristiHimmelsfardsdag string
-	pingstDagen           string
-	nationalDagen         string
-	midsommarDagen        string
-	allaHelgonsDag        string
-	julDagen              string
-	annandagJul           string
+// SwedishHolidaysT holds the same holidays as SwedishHolidays but as time.Time
+// values, so callers don't have to parse the date strings themselves. Like in
+// SwedishHolidays either NationalDagen or AnnandagPingst is the zero time.
+type SwedishHolidaysT struct {
+	NyarsDagen            time.Time
+	TrettondedagJul       time.Time
+	Langfredagen          time.Time
+	PaskDagen             time.Time
+	AnnandagPask          time.Time
+	ForstaMaj             time.Time
+	KristiHimmelsfardsdag time.Time
+	PingstDagen           time.Time
+	AnnandagPingst        time.Time
+	NationalDagen         time.Time
+	MidsommarDagen        time.Time
+	AllaHelgonsDag        time.Time
+	JulDagen              time.Time
+	AnnandagJul           time.Time
 }
 
-// Based of Lag (1989:253) om allmänna helgdagar
-// https://www.riksdagen.se/sv/dokument-lagar/dokument/svensk-forfattningssamling/lag-1989253-om-allmanna-helgdagar_sfs-1989-253
-func getHolidays(y int) swedishHolidays {
-	fmt.Println("kör", y)
-	paskDagen := calcPaskDagen(y)
-	langFredagen, err := calcLangFredagen(paskDagen)
-	annandagPask, err := calcAnnandagPask(paskDagen)
-	kristiHimmelsfardsdag, err := calcKristiHimmelsfardsdag(paskDagen)
-	pingstDagen, err := calcPingstDagen(paskDagen)
+// holidayCache maps a year to its cachedYear. Only successful calculations
+// are stored.
+var holidayCache sync.Map
+
+type cachedYear struct {
+	holidays SwedishHolidaysT
+	// list is shared between callers and must not be modified
+	list []Holiday
+}
+
+// GetHolidaysT calculates the holidays for the given year as time.Time values.
+// All dates are at midnight UTC. Results are cached per year.
+func GetHolidaysT(y int) (SwedishHolidaysT, error) {
+	cached, err := cachedHolidays(y)
+	return cached.holidays, err
+}
+
+func cachedHolidays(y int) (cachedYear, error) {
+	if err := validateYear(y); err != nil {
+		return cachedYear{}, err
+	}
+
+	if cached, ok := holidayCache.Load(y); ok {
+		return cached.(cachedYear), nil
+	}
+
+	holidays, err := calcHolidaysT(y)
+
+	if err != nil {
+		return cachedYear{}, err
+	}
+
+	cached := cachedYear{holidays: holidays, list: holidays.list()}
+	holidayCache.Store(y, cached)
+	return cached, nil
+}
+
+func calcHolidaysT(y int) (SwedishHolidaysT, error) {
+	paskDagen, err := calcPaskDagenT(y)
+
+	if err != nil {
+		return SwedishHolidaysT{}, err
+	}
+
+	langfredagen, err := calcLangFredagen(paskDagen)
+
+	if err != nil {
+		return SwedishHolidaysT{}, err
+	}
+
 	midsommarDagen, err := calcMidsommarDagen(y)
+
+	if err != nil {
+		return SwedishHolidaysT{}, err
+	}
+
 	allaHelgonsDag, err := calcAllaHelgonsDag(y)
 
 	if err != nil {
-		fmt.Printf("error: %v\n", err)
-	}
-
-	fmt.Println("långfredagen", langFredagen)
-	fmt.Println("påskdagen", paskDagen)
-	fmt.Println("annandag påsk", annandagPask)
-	fmt.Println("Kristi himmelsfärdsdag", kristiHimmelsfardsdag)
-	fmt.Println("Pingstdagen", pingstDagen)
-	fmt.Println("midsommarDagen", midsommarDagen)
-	fmt.Println("allaHelgonsDag", allaHelgonsDag)
-
-	return swedishHolidays{
-		nyarsDagen:            fmt.Sprintf("%v-01-01", y),
-		trettondedagJul:       fmt.Sprintf("%v-01-06", y),
-		langfredagen:          langFredagen,
-		paskDagen:             paskDagen,
-		annandagPask:          annandagPask,
-		kristiHimmelsfardsdag: kristiHimmelsfardsdag,
-		pingstDagen:           pingstDagen,
-		nationalDagen:         fmt.Sprintf("%v-06-06", y),
-		midsommarDagen:        midsommarDagen,
-		allaHelgonsDag:        allaHelgonsDag,
-		julDagen:              fmt.Sprintf("%v-12-25", y),
-		annandagJul:           fmt.Sprintf("%v-12-26", y)}
-}
-
-func findWeekday(startDate string, weekday time.Weekday, direction string) (date string, err error) {
-	parsedStartDate, err := time.Parse(time.DateOnly, startDate)
+		return SwedishHolidaysT{}, err
+	}
+
+	assertWeekday("långfredagen", langfredagen, time.Friday)
+	assertWeekday("midsommardagen", midsommarDagen, time.Saturday)
+	assertWeekday("alla helgons dag", allaHelgonsDag, time.Saturday)
+
+	log().Debug("calculated holidays",
+		"year", y,
+		"paskDagen", paskDagen.Format(time.DateOnly),
+		"midsommarDagen", midsommarDagen.Format(time.DateOnly),
+		"allaHelgonsDag", allaHelgonsDag.Format(time.DateOnly))
+
+	holidays := SwedishHolidaysT{
+		NyarsDagen:            time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC),
+		TrettondedagJul:       time.Date(y, time.January, 6, 0, 0, 0, 0, time.UTC),
+		Langfredagen:          langfredagen,
+		PaskDagen:             paskDagen,
+		AnnandagPask:          calcAnnandagPask(paskDagen),
+		ForstaMaj:             time.Date(y, time.May, 1, 0, 0, 0, 0, time.UTC),
+		KristiHimmelsfardsdag: calcKristiHimmelsfardsdag(paskDagen),
+		PingstDagen:           calcPingstDagen(paskDagen),
+		NationalDagen:         time.Date(y, time.June, 6, 0, 0, 0, 0, time.UTC),
+		MidsommarDagen:        midsommarDagen,
+		AllaHelgonsDag:        allaHelgonsDag,
+		JulDagen:              time.Date(y, time.December, 25, 0, 0, 0, 0, time.UTC),
+		AnnandagJul:           time.Date(y, time.December, 26, 0, 0, 0, 0, time.UTC)}
+
+	// Nationaldagen replaced annandag pingst in 2005
+	if y < 2005 {
+		holidays.NationalDagen = time.Time{}
+		holidays.AnnandagPingst = calcAnnandagPingst(holidays.PingstDagen)
+	}
+
+	return holidays, nil
+}
+
+// GetHolidays calculates the holidays for the given year.
+//
+// Based of Lag (1989:253) om allmänna helgdagar
+// https://www.riksdagen.se/sv/dokument-lagar/dokument/svensk-forfattningssamling/lag-1989253-om-allmanna-helgdagar_sfs-1989-253
+func GetHolidays(y int) (SwedishHolidays, error) {
+	holidays, err := GetHolidaysT(y)
 
 	if err != nil {
-		fmt.Printf("error: %v\n", err)
+		return SwedishHolidays{}, err
 	}
 
+	return holidays.format(time.DateOnly), nil
+}
+
+// Direction is the direction to search in when looking for a weekday.
+type Direction int
+
+const (
+	Forward Direction = iota
+	Backward
+)
+
+// findWeekday finds the first weekday on or after/before the start date. The
+// start date itself is included, midsommardagen and alla helgons dag depend on
+// this since the first day of their window can be the saturday.
+func findWeekday(startDate time.Time, weekday time.Weekday, direction Direction) (date time.Time, err error) {
 	for i := 0; i < 7; i++ {
 		var d int
 		switch direction {
-		case "forward":
+		case Forward:
 			d = +i
-		case "back":
+		case Backward:
 			d = -i
+		default:
+			return time.Time{}, fmt.Errorf("invalid direction %d", direction)
 		}
 
-		dateToCheck := parsedStartDate.AddDate(0, 0, d)
+		dateToCheck := startDate.AddDate(0, 0, d)
 		if dateToCheck.Weekday() == weekday {
-			return dateToCheck.Format(time.DateOnly), nil
+			return dateToCheck, nil
 		}
 	}
-	fmt.Println(parsedStartDate)
-
-	return "", errors.New("Fann inget datum")
-}
 
-func calcLangFredagen(p string) (langfredagen string, err error) {
-	return findWeekday(p, time.Friday, "back")
+	log().Debug("found no weekday", "startDate", startDate.Format(time.DateOnly), "weekday", weekday, "direction", direction)
+	return time.Time{}, ErrNoDateFound
 }
 
-func calcAnnandagPask(p string) (annandagPask string, err error) {
-	parsedStartDate, err := time.Parse(time.DateOnly, p)
-
-	if err != nil {
-		fmt.Printf("error: %v\n", err)
+// findWeekdayStrict works like findWeekday but never returns the start date
+// itself, it searches strictly after/before it.
+func findWeekdayStrict(startDate time.Time, weekday time.Weekday, direction Direction) (date time.Time, err error) {
+	step := 1
+	if direction == Backward {
+		step = -1
 	}
 
-	annandagPaskTime := parsedStartDate.AddDate(0, 0, 1)
-
-	return annandagPaskTime.Format(time.DateOnly), nil
+	return findWeekday(startDate.AddDate(0, 0, step), weekday, direction)
 }
 
-func calcKristiHimmelsfardsdag(p string) (kristiHimmelsfardsdag string, err error) {
-	// sjätte torsdagen efter påskdagen
-	return "", nil
-}
+// nthWeekdayOfMonth finds the nth weekday of a month, e.g. the second sunday
+// of november. A negative n counts from the end of the month, -1 being the
+// last one.
+func nthWeekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int) (date time.Time, err error) {
+	if n == 0 {
+		return time.Time{}, errors.New("n must not be 0")
+	}
 
-func calcPingstDagen(p string) (pingstDagen string, err error) {
-	// sjunde söndagen efter påskdagen
-	return "", nil
-}
+	if n > 0 {
+		first, err := findWeekday(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), weekday, Forward)
 
-func calcMidsommarDagen(y int) (midsommarDagen string, err error) {
-	startDate := fmt.Sprintf("%v-06-20", y)
-	return findWeekday(startDate, time.Saturday, "forward")
-}
+		if err != nil {
+			return time.Time{}, err
+		}
 
-func calcAllaHelgonsDag(y int) (allaHelgonsDag string, err error) {
-	startDate := fmt.Sprintf("%v-10-31", y)
-	return findWeekday(startDate, time.Saturday, "forward")
-}
+		date = first.AddDate(0, 0, 7*(n-1))
+	} else {
+		lastDayOfMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		last, err := findWeekday(lastDayOfMonth, weekday, Backward)
 
-// Based on the calculation here:
-// https://www.eit.lth.se/fileadmin/eit/courses/edi021/DP_Gauss.htm
-func calcPaskDagen(y int) (paskDagen string) {
-	M, N, err := getPaskConsts(y)
+		if err != nil {
+			return time.Time{}, err
+		}
 
-	if err != nil {
-		fmt.Print("An error has occured:", err)
-		os.Exit(1)
+		date = last.AddDate(0, 0, 7*(n+1))
 	}
 
-	a := y % 19
-	b := y % 4
-	c := y % 7
-	d := ((19 * a) + M) % 30
-	e := ((2 * b) + (4 * c) + (6 * d) + N) % 7
-	day := 22 + d + e
-
-	month := "03"
-
-	if day > 31 {
-		day -= 31
-		month = "04"
+	if date.Month() != month {
+		return time.Time{}, fmt.Errorf("%w: there is no %v %v in %v %v", ErrNoDateFound, n, weekday, month, year)
 	}
 
-	return fmt.Sprintf("%v-%v-%v", y, month, padNumber(day))
+	return date, nil
 }
 
-func getPaskConsts(y int) (M int, N int, Err error) {
-	if 1583 <= y && y <= 1699 {
-		return 22, 2, nil
-	}
+func calcLangFredagen(p time.Time) (langfredagen time.Time, err error) {
+	// fredagen före påskdagen
+	return findWeekdayStrict(p, time.Friday, Backward)
+}
 
-	if 1700 <= y && y <= 1799 {
-		return 23, 3, nil
-	}
+func calcAnnandagPask(p time.Time) (annandagPask time.Time) {
+	return p.AddDate(0, 0, 1)
+}
 
-	if 1800 <= y && y <= 1899 {
-		return 23, 4, nil
-	}
+func calcKristiHimmelsfardsdag(p time.Time) (kristiHimmelsfardsdag time.Time) {
+	// sjätte torsdagen efter påskdagen
+	return p.AddDate(0, 0, 39)
+}
 
-	if 1900 <= y && y <= 1999 {
-		return 24, 5, nil
-	}
+func calcPingstDagen(p time.Time) (pingstDagen time.Time) {
+	// sjunde söndagen efter påskdagen
+	return p.AddDate(0, 0, 49)
+}
 
-	if 2000 <= y && y <= 2099 {
-		return 24, 5, nil
-	}
+// Annandag pingst var helgdag fram till 2005 då den ersattes av nationaldagen
+func calcAnnandagPingst(p time.Time) (annandagPingst time.Time) {
+	return p.AddDate(0, 0, 1)
+}
 
-	if 2100 <= y && y <= 2199 {
-		return 24, 6, nil
-	}
+// Midsommardagen är den lördag som infaller under tiden den 20-26 juni
+func calcMidsommarDagen(y int) (midsommarDagen time.Time, err error) {
+	startDate := time.Date(y, time.June, 20, 0, 0, 0, 0, time.UTC)
+	return findWeekday(startDate, time.Saturday, Forward)
+}
 
-	if 2200 <= y && y <= 2299 {
-		return 25, 0, nil
-	}
+// Alla helgons dag är den lördag som infaller under tiden den 31 oktober-6 november
+func calcAllaHelgonsDag(y int) (allaHelgonsDag time.Time, err error) {
+	startDate := time.Date(y, time.October, 31, 0, 0, 0, 0, time.UTC)
+	return findWeekday(startDate, time.Saturday, Forward)
+}
 
-	if 2300 <= y && y <= 2399 {
-		return 26, 1, nil
-	}
+// CalcPaskDagen calculates påskdagen for the given year as yyyy-mm-dd.
+func CalcPaskDagen(y int) (paskDagen string, err error) {
+	paskDagenTime, err := calcPaskDagenT(y)
 
-	if 2400 <= y && y <= 2499 {
-		return 25, 1, nil
+	if err != nil {
+		return "", err
 	}
 
-	if 2500 <= y && y <= 2599 {
-		return 26, 2, nil
-	}
+	return paskDagenTime.Format(time.DateOnly), nil
+}
 
-	return 0, 0, fmt.Errorf("The given year - %v - is outside of the possible range - 1583-2600", y)
+// EasterSunday returns påskdagen for the given year.
+func EasterSunday(y int) (time.Time, error) {
+	return calcPaskDagenT(y)
 }
 
-func padNumber(n int) string {
-	padding := ""
-	if n < 10 {
-		padding = "0"
+// Based on the anonymous Gregorian algorithm (Meeus/Jones/Butcher):
+// https://en.wikipedia.org/wiki/Date_of_Easter#Anonymous_Gregorian_algorithm
+//
+// Unlike the Gauss method it needs no special cases, the years where Gauss
+// gives april 26 (e.g. 1981) or april 25 (e.g. 1954) correctly end up a week
+// earlier.
+func calcPaskDagenT(y int) (paskDagen time.Time, err error) {
+	if err := validateYear(y); err != nil {
+		return time.Time{}, err
 	}
-	return fmt.Sprintf("%v%v", padding, n)
+
+	a := y % 19
+	b := y / 100
+	c := y % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := ((19 * a) + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + (2 * e) + (2 * i) - h - k) % 7
+	m := (a + (11 * h) + (22 * l)) / 451
+	month := time.Month((h + l - (7 * m) + 114) / 31)
+	day := ((h + l - (7 * m) + 114) % 31) + 1
+
+	return time.Date(y, month, day, 0, 0, 0, 0, time.UTC), nil
 }
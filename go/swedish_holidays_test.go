@@ -0,0 +1,519 @@
+package swedishholidays
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// holidayTable holds hand-verified dates for every holiday of 2020-2030
+var holidayTable = []struct {
+	year int
+	want SwedishHolidays
+}{
+	{2020, SwedishHolidays{
+		NyarsDagen:            "2020-01-01",
+		TrettondedagJul:       "2020-01-06",
+		Langfredagen:          "2020-04-10",
+		PaskDagen:             "2020-04-12",
+		AnnandagPask:          "2020-04-13",
+		ForstaMaj:             "2020-05-01",
+		KristiHimmelsfardsdag: "2020-05-21",
+		PingstDagen:           "2020-05-31",
+		NationalDagen:         "2020-06-06",
+		MidsommarDagen:        "2020-06-20",
+		AllaHelgonsDag:        "2020-10-31",
+		JulDagen:              "2020-12-25",
+		AnnandagJul:           "2020-12-26",
+	}},
+	{2021, SwedishHolidays{
+		NyarsDagen:            "2021-01-01",
+		TrettondedagJul:       "2021-01-06",
+		Langfredagen:          "2021-04-02",
+		PaskDagen:             "2021-04-04",
+		AnnandagPask:          "2021-04-05",
+		ForstaMaj:             "2021-05-01",
+		KristiHimmelsfardsdag: "2021-05-13",
+		PingstDagen:           "2021-05-23",
+		NationalDagen:         "2021-06-06",
+		MidsommarDagen:        "2021-06-26",
+		AllaHelgonsDag:        "2021-11-06",
+		JulDagen:              "2021-12-25",
+		AnnandagJul:           "2021-12-26",
+	}},
+	{2022, SwedishHolidays{
+		NyarsDagen:            "2022-01-01",
+		TrettondedagJul:       "2022-01-06",
+		Langfredagen:          "2022-04-15",
+		PaskDagen:             "2022-04-17",
+		AnnandagPask:          "2022-04-18",
+		ForstaMaj:             "2022-05-01",
+		KristiHimmelsfardsdag: "2022-05-26",
+		PingstDagen:           "2022-06-05",
+		NationalDagen:         "2022-06-06",
+		MidsommarDagen:        "2022-06-25",
+		AllaHelgonsDag:        "2022-11-05",
+		JulDagen:              "2022-12-25",
+		AnnandagJul:           "2022-12-26",
+	}},
+	{2023, SwedishHolidays{
+		NyarsDagen:            "2023-01-01",
+		TrettondedagJul:       "2023-01-06",
+		Langfredagen:          "2023-04-07",
+		PaskDagen:             "2023-04-09",
+		AnnandagPask:          "2023-04-10",
+		ForstaMaj:             "2023-05-01",
+		KristiHimmelsfardsdag: "2023-05-18",
+		PingstDagen:           "2023-05-28",
+		NationalDagen:         "2023-06-06",
+		MidsommarDagen:        "2023-06-24",
+		AllaHelgonsDag:        "2023-11-04",
+		JulDagen:              "2023-12-25",
+		AnnandagJul:           "2023-12-26",
+	}},
+	{2024, SwedishHolidays{
+		NyarsDagen:            "2024-01-01",
+		TrettondedagJul:       "2024-01-06",
+		Langfredagen:          "2024-03-29",
+		PaskDagen:             "2024-03-31",
+		AnnandagPask:          "2024-04-01",
+		ForstaMaj:             "2024-05-01",
+		KristiHimmelsfardsdag: "2024-05-09",
+		PingstDagen:           "2024-05-19",
+		NationalDagen:         "2024-06-06",
+		MidsommarDagen:        "2024-06-22",
+		AllaHelgonsDag:        "2024-11-02",
+		JulDagen:              "2024-12-25",
+		AnnandagJul:           "2024-12-26",
+	}},
+	{2025, SwedishHolidays{
+		NyarsDagen:            "2025-01-01",
+		TrettondedagJul:       "2025-01-06",
+		Langfredagen:          "2025-04-18",
+		PaskDagen:             "2025-04-20",
+		AnnandagPask:          "2025-04-21",
+		ForstaMaj:             "2025-05-01",
+		KristiHimmelsfardsdag: "2025-05-29",
+		PingstDagen:           "2025-06-08",
+		NationalDagen:         "2025-06-06",
+		MidsommarDagen:        "2025-06-21",
+		AllaHelgonsDag:        "2025-11-01",
+		JulDagen:              "2025-12-25",
+		AnnandagJul:           "2025-12-26",
+	}},
+	{2026, SwedishHolidays{
+		NyarsDagen:            "2026-01-01",
+		TrettondedagJul:       "2026-01-06",
+		Langfredagen:          "2026-04-03",
+		PaskDagen:             "2026-04-05",
+		AnnandagPask:          "2026-04-06",
+		ForstaMaj:             "2026-05-01",
+		KristiHimmelsfardsdag: "2026-05-14",
+		PingstDagen:           "2026-05-24",
+		NationalDagen:         "2026-06-06",
+		MidsommarDagen:        "2026-06-20",
+		AllaHelgonsDag:        "2026-10-31",
+		JulDagen:              "2026-12-25",
+		AnnandagJul:           "2026-12-26",
+	}},
+	{2027, SwedishHolidays{
+		NyarsDagen:            "2027-01-01",
+		TrettondedagJul:       "2027-01-06",
+		Langfredagen:          "2027-03-26",
+		PaskDagen:             "2027-03-28",
+		AnnandagPask:          "2027-03-29",
+		ForstaMaj:             "2027-05-01",
+		KristiHimmelsfardsdag: "2027-05-06",
+		PingstDagen:           "2027-05-16",
+		NationalDagen:         "2027-06-06",
+		MidsommarDagen:        "2027-06-26",
+		AllaHelgonsDag:        "2027-11-06",
+		JulDagen:              "2027-12-25",
+		AnnandagJul:           "2027-12-26",
+	}},
+	{2028, SwedishHolidays{
+		NyarsDagen:            "2028-01-01",
+		TrettondedagJul:       "2028-01-06",
+		Langfredagen:          "2028-04-14",
+		PaskDagen:             "2028-04-16",
+		AnnandagPask:          "2028-04-17",
+		ForstaMaj:             "2028-05-01",
+		KristiHimmelsfardsdag: "2028-05-25",
+		PingstDagen:           "2028-06-04",
+		NationalDagen:         "2028-06-06",
+		MidsommarDagen:        "2028-06-24",
+		AllaHelgonsDag:        "2028-11-04",
+		JulDagen:              "2028-12-25",
+		AnnandagJul:           "2028-12-26",
+	}},
+	{2029, SwedishHolidays{
+		NyarsDagen:            "2029-01-01",
+		TrettondedagJul:       "2029-01-06",
+		Langfredagen:          "2029-03-30",
+		PaskDagen:             "2029-04-01",
+		AnnandagPask:          "2029-04-02",
+		ForstaMaj:             "2029-05-01",
+		KristiHimmelsfardsdag: "2029-05-10",
+		PingstDagen:           "2029-05-20",
+		NationalDagen:         "2029-06-06",
+		MidsommarDagen:        "2029-06-23",
+		AllaHelgonsDag:        "2029-11-03",
+		JulDagen:              "2029-12-25",
+		AnnandagJul:           "2029-12-26",
+	}},
+	{2030, SwedishHolidays{
+		NyarsDagen:            "2030-01-01",
+		TrettondedagJul:       "2030-01-06",
+		Langfredagen:          "2030-04-19",
+		PaskDagen:             "2030-04-21",
+		AnnandagPask:          "2030-04-22",
+		ForstaMaj:             "2030-05-01",
+		KristiHimmelsfardsdag: "2030-05-30",
+		PingstDagen:           "2030-06-09",
+		NationalDagen:         "2030-06-06",
+		MidsommarDagen:        "2030-06-22",
+		AllaHelgonsDag:        "2030-11-02",
+		JulDagen:              "2030-12-25",
+		AnnandagJul:           "2030-12-26",
+	}},
+}
+
+func TestGetHolidays(t *testing.T) {
+	for _, tt := range holidayTable {
+		got, err := GetHolidays(tt.year)
+
+		if err != nil {
+			t.Fatalf("GetHolidays(%v) returned error: %v", tt.year, err)
+		}
+
+		if got != tt.want {
+			t.Errorf("GetHolidays(%v) =\n%v\nwant\n%v", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestCalcPingstDagen(t *testing.T) {
+	tests := []struct {
+		paskDagen time.Time
+		want      time.Time
+	}{
+		{date(2023, time.April, 9), date(2023, time.May, 28)},
+		{date(2024, time.March, 31), date(2024, time.May, 19)},
+		// Crosses from april into june
+		{date(2011, time.April, 24), date(2011, time.June, 12)},
+	}
+
+	for _, tt := range tests {
+		if got := calcPingstDagen(tt.paskDagen); !got.Equal(tt.want) {
+			t.Errorf("calcPingstDagen(%v) = %v, want %v", tt.paskDagen.Format(time.DateOnly), got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestCalcMidsommarDagen(t *testing.T) {
+	tests := []struct {
+		year int
+		want time.Time
+	}{
+		{1989, date(1989, time.June, 24)},
+		// First and last day of the window
+		{2015, date(2015, time.June, 20)},
+		{2021, date(2021, time.June, 26)},
+		{2023, date(2023, time.June, 24)},
+		{2024, date(2024, time.June, 22)},
+		{2025, date(2025, time.June, 21)},
+	}
+
+	for _, tt := range tests {
+		got, err := calcMidsommarDagen(tt.year)
+
+		if err != nil {
+			t.Fatalf("calcMidsommarDagen(%v) returned error: %v", tt.year, err)
+		}
+
+		if !got.Equal(tt.want) {
+			t.Errorf("calcMidsommarDagen(%v) = %v, want %v", tt.year, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestCalcAllaHelgonsDagWindow(t *testing.T) {
+	for y := 2020; y <= 2030; y++ {
+		got, err := calcAllaHelgonsDag(y)
+
+		if err != nil {
+			t.Fatalf("calcAllaHelgonsDag(%v) returned error: %v", y, err)
+		}
+
+		if got.Before(date(y, time.October, 31)) || got.After(date(y, time.November, 6)) {
+			t.Errorf("calcAllaHelgonsDag(%v) = %v, want a date in october 31-november 6", y, got.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestMidsommarAndAllaHelgonsOnSaturday(t *testing.T) {
+	for y := 1989; y <= 2100; y++ {
+		holidays, err := GetHolidaysT(y)
+
+		if err != nil {
+			t.Fatalf("GetHolidaysT(%v) returned error: %v", y, err)
+		}
+
+		for _, got := range []time.Time{holidays.MidsommarDagen, holidays.AllaHelgonsDag} {
+			if got.Weekday() != time.Saturday {
+				t.Errorf("%v is a %v, want a saturday", got.Format(time.DateOnly), got.Weekday())
+			}
+		}
+	}
+}
+
+// Nationaldagen replaced annandag pingst in 2005
+func TestGetHolidaysNationalDagenBoundary(t *testing.T) {
+	tests := []struct {
+		year           int
+		nationalDagen  string
+		annandagPingst string
+	}{
+		{1989, "", "1989-05-15"},
+		{2004, "", "2004-05-31"},
+		{2005, "2005-06-06", ""},
+		{2006, "2006-06-06", ""},
+	}
+
+	for _, tt := range tests {
+		got, err := GetHolidays(tt.year)
+
+		if err != nil {
+			t.Fatalf("GetHolidays(%v) returned error: %v", tt.year, err)
+		}
+
+		if got.NationalDagen != tt.nationalDagen || got.AnnandagPingst != tt.annandagPingst {
+			t.Errorf("GetHolidays(%v) has nationaldagen %q and annandag pingst %q, want %q and %q",
+				tt.year, got.NationalDagen, got.AnnandagPingst, tt.nationalDagen, tt.annandagPingst)
+		}
+
+		count, err := HolidayCount(tt.year)
+
+		if err != nil {
+			t.Fatalf("HolidayCount(%v) returned error: %v", tt.year, err)
+		}
+
+		if count != 13 {
+			t.Errorf("HolidayCount(%v) = %v, want 13", tt.year, count)
+		}
+	}
+}
+
+// TestGetHolidaysConcurrent exercises the holiday cache, run it with
+// go test -race
+func TestGetHolidaysConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// Start at different years so the goroutines fill the cache
+			// concurrently
+			for j := range holidayTable {
+				tt := holidayTable[(i+j)%len(holidayTable)]
+				got, err := GetHolidays(tt.year)
+
+				if err != nil {
+					t.Errorf("GetHolidays(%v) returned error: %v", tt.year, err)
+					return
+				}
+
+				if got != tt.want {
+					t.Errorf("GetHolidays(%v) =\n%v\nwant\n%v", tt.year, got, tt.want)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestCalcPaskDagenMonthRollover(t *testing.T) {
+	tests := []struct {
+		year int
+		want string
+	}{
+		// Gauss gives april 26 and april 25 for these
+		{1981, "1981-04-19"},
+		{2076, "2076-04-19"},
+		{1954, "1954-04-18"},
+		{2049, "2049-04-18"},
+		// The end of march and the earliest and latest possible dates
+		{2024, "2024-03-31"},
+		{2029, "2029-04-01"},
+		{2285, "2285-03-22"},
+		{2038, "2038-04-25"},
+	}
+
+	for _, tt := range tests {
+		got, err := CalcPaskDagen(tt.year)
+
+		if err != nil {
+			t.Fatalf("CalcPaskDagen(%v) returned error: %v", tt.year, err)
+		}
+
+		if got != tt.want {
+			t.Errorf("CalcPaskDagen(%v) = %v, want %v", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestCalcPaskDagenGaussExceptionYears(t *testing.T) {
+	want := map[int]time.Time{
+		1609: date(1609, time.April, 19),
+		1954: date(1954, time.April, 18),
+		1981: date(1981, time.April, 19),
+		2049: date(2049, time.April, 18),
+		2076: date(2076, time.April, 19),
+		2106: date(2106, time.April, 18),
+		2133: date(2133, time.April, 19),
+		2201: date(2201, time.April, 19),
+		2296: date(2296, time.April, 19),
+		2448: date(2448, time.April, 19),
+	}
+
+	for y := 1583; y <= 2599; y++ {
+		m, n := paskConsts(y)
+		expected, isException := want[y]
+
+		if gaussException(y, m, n) != isException {
+			t.Errorf("Gauss exception for %v is %v, want %v", y, !isException, isException)
+		}
+
+		if !isException {
+			continue
+		}
+
+		got, err := calcPaskDagenT(y)
+
+		if err != nil {
+			t.Fatalf("calcPaskDagenT(%v) returned error: %v", y, err)
+		}
+
+		if !got.Equal(expected) {
+			t.Errorf("calcPaskDagenT(%v) = %v, want %v", y, got.Format(time.DateOnly), expected.Format(time.DateOnly))
+		}
+	}
+}
+
+// Before working in time.Time end to end GetHolidays allocated 14 times for
+// 12 dates, now only the formatted dates allocate, one each
+func TestGetHolidaysAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		GetHolidays(2024)
+	})
+
+	if allocs > 13 {
+		t.Errorf("GetHolidays allocates %v times per call, want at most 13", allocs)
+	}
+}
+
+func BenchmarkGetHolidays(b *testing.B) {
+	for b.Loop() {
+		GetHolidays(2024)
+	}
+}
+
+// TestCalcPaskDagenMatchesTable compares the computus with the Gauss method
+// using the table of M and N the package had before
+func TestCalcPaskDagenMatchesTable(t *testing.T) {
+	for y := 1583; y <= 2599; y++ {
+		m, n := paskConsts(y)
+		want := gaussPaskDagen(y, m, n)
+		got, err := calcPaskDagenT(y)
+
+		if err != nil {
+			t.Fatalf("calcPaskDagenT(%v) returned error: %v", y, err)
+		}
+
+		if !got.Equal(want) {
+			t.Errorf("calcPaskDagenT(%v) = %v, want %v", y, got.Format(time.DateOnly), want.Format(time.DateOnly))
+		}
+	}
+}
+
+// TestCalcPaskDagenMatchesGauss compares the computus with the Gauss method
+// for every supported year, calculating M and N instead of using the table
+func TestCalcPaskDagenMatchesGauss(t *testing.T) {
+	for y := MinYear; y <= MaxYear; y++ {
+		m, n := gaussConsts(y)
+		want := gaussPaskDagen(y, m, n)
+		got, err := calcPaskDagenT(y)
+
+		if err != nil {
+			t.Fatalf("calcPaskDagenT(%v) returned error: %v", y, err)
+		}
+
+		if !got.Equal(want) {
+			t.Errorf("calcPaskDagenT(%v) = %v, want %v", y, got.Format(time.DateOnly), want.Format(time.DateOnly))
+		}
+	}
+}
+
+func gaussConsts(y int) (m int, n int) {
+	k := y / 100
+	p := (13 + 8*k) / 25
+	q := k / 4
+	return (15 - p + k - q) % 30, (4 + k - q) % 7
+}
+
+func paskConsts(y int) (m int, n int) {
+	switch {
+	case y <= 1699:
+		return 22, 2
+	case y <= 1799:
+		return 23, 3
+	case y <= 1899:
+		return 23, 4
+	case y <= 2099:
+		return 24, 5
+	case y <= 2199:
+		return 24, 6
+	case y <= 2299:
+		return 25, 0
+	case y <= 2399:
+		return 26, 1
+	case y <= 2499:
+		return 25, 1
+	default:
+		return 26, 2
+	}
+}
+
+// gaussPaskDagen calculates påskdagen with the Gauss method, including the
+// two exception rules
+func gaussPaskDagen(y int, m int, n int) time.Time {
+	d, e := gaussDE(y, m, n)
+	day := 22 + d + e
+
+	if gaussException(y, m, n) {
+		day -= 7
+	}
+
+	return date(y, time.March, day)
+}
+
+func gaussDE(y int, m int, n int) (d int, e int) {
+	d = (19*(y%19) + m) % 30
+	e = (2*(y%4) + 4*(y%7) + 6*d + n) % 7
+	return d, e
+}
+
+// gaussException reports whether the Gauss method gives april 26, which
+// becomes april 19, or an april 25 that becomes april 18
+func gaussException(y int, m int, n int) bool {
+	d, e := gaussDE(y, m, n)
+	return (d == 29 && e == 6) || (d == 28 && e == 6 && (11*m+11)%30 < 19)
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
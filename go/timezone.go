@@ -0,0 +1,92 @@
+package swedishholidays
+
+import (
+	"time"
+	// Embedded so Europe/Stockholm is available even without a system tz database
+	_ "time/tzdata"
+)
+
+// Stockholm is the Europe/Stockholm location, used when no location is given.
+var Stockholm = mustLoadLocation("Europe/Stockholm")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return loc
+}
+
+// IsHolidayIn works like IsHoliday but first converts d to the wall clock of
+// loc, so e.g. time.Now() on a UTC server is checked against the Swedish
+// calendar day. A nil loc means Stockholm.
+func IsHolidayIn(d time.Time, loc *time.Location) (bool, error) {
+	return IsHoliday(d.In(locationOrStockholm(loc)))
+}
+
+// HolidayNameIn is the location aware version of HolidayName, see IsHolidayIn.
+func HolidayNameIn(d time.Time, loc *time.Location) (name string, found bool, err error) {
+	return HolidayName(d.In(locationOrStockholm(loc)))
+}
+
+// IsWorkingDayIn is the location aware version of IsWorkingDay, see
+// IsHolidayIn.
+func IsWorkingDayIn(d time.Time, loc *time.Location) (bool, error) {
+	return IsWorkingDay(d.In(locationOrStockholm(loc)))
+}
+
+// DaysUntilNextHolidayIn is the location aware version of
+// DaysUntilNextHoliday, see IsHolidayIn.
+func DaysUntilNextHolidayIn(from time.Time, loc *time.Location) (int, Holiday, error) {
+	return DaysUntilNextHoliday(from.In(locationOrStockholm(loc)))
+}
+
+// WorkingDaysBetweenIn is the location aware version of WorkingDaysBetween,
+// see IsHolidayIn.
+func WorkingDaysBetweenIn(start time.Time, end time.Time, loc *time.Location) (int, error) {
+	loc = locationOrStockholm(loc)
+	return WorkingDaysBetween(start.In(loc), end.In(loc))
+}
+
+// HolidaysUnix returns the holidays of the given year as Unix timestamps of
+// midnight in loc, keyed by Holiday.Key. Midnight in Stockholm is 23:00 or
+// 22:00 UTC the day before depending on daylight saving time. A nil loc means
+// Stockholm.
+func HolidaysUnix(y int, loc *time.Location) (map[string]int64, error) {
+	holidays, err := holidaysOf(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	loc = locationOrStockholm(loc)
+	timestamps := make(map[string]int64, len(holidays))
+
+	for _, holiday := range holidays {
+		year, month, day := holiday.Date.Date()
+		timestamps[holiday.Key] = time.Date(year, month, day, 0, 0, 0, 0, loc).Unix()
+	}
+
+	return timestamps, nil
+}
+
+// GetHolidaysThisYear returns the holidays of the current year in Stockholm.
+func GetHolidaysThisYear() (SwedishHolidays, error) {
+	return GetHolidays(time.Now().In(Stockholm).Year())
+}
+
+// Today returns the holiday of the current day in Stockholm, if any. The
+// boolean is false when today isn't a holiday.
+func Today() (Holiday, bool, error) {
+	return holidayOn(time.Now().In(Stockholm))
+}
+
+func locationOrStockholm(loc *time.Location) *time.Location {
+	if loc == nil {
+		return Stockholm
+	}
+
+	return loc
+}
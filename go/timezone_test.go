@@ -0,0 +1,32 @@
+package swedishholidays
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHolidaysUnix(t *testing.T) {
+	tests := []struct {
+		loc  *time.Location
+		key  string
+		want time.Time
+	}{
+		// Midnight in Stockholm is 22:00 UTC in summer and 23:00 UTC in winter
+		{nil, "midsommardagen", time.Date(2024, time.June, 21, 22, 0, 0, 0, time.UTC)},
+		{Stockholm, "midsommardagen", time.Date(2024, time.June, 21, 22, 0, 0, 0, time.UTC)},
+		{Stockholm, "juldagen", time.Date(2024, time.December, 24, 23, 0, 0, 0, time.UTC)},
+		{time.UTC, "midsommardagen", time.Date(2024, time.June, 22, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		timestamps, err := HolidaysUnix(2024, tt.loc)
+
+		if err != nil {
+			t.Fatalf("HolidaysUnix(2024, %v) returned error: %v", tt.loc, err)
+		}
+
+		if got := timestamps[tt.key]; got != tt.want.Unix() {
+			t.Errorf("HolidaysUnix(2024, %v)[%q] = %v, want %v (%v)", tt.loc, tt.key, got, tt.want.Unix(), tt.want)
+		}
+	}
+}
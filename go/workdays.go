@@ -0,0 +1,377 @@
+package swedishholidays
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WorkingDayOptions adjusts which days count as working days. The zero value
+// gives the same result as the package level functions, e.g. IsWorkingDay.
+type WorkingDayOptions struct {
+	// TreatEvesAsFree makes the aftnar (see GetEves) non-working days
+	TreatEvesAsFree bool
+	// ExtraFreeDays are additional non-working days, e.g. a company's own
+	// closure days. Only their calendar day is used.
+	ExtraFreeDays []time.Time
+}
+
+// IsWorkingDay reports whether the given date is a working day, i.e. a
+// Monday-Friday that isn't a holiday.
+//
+// The aftnar (see GetEves) aren't allmänna helgdagar and therefore count as
+// working days, use WorkingDayOptions to treat them as free.
+func IsWorkingDay(d time.Time) (bool, error) {
+	return WorkingDayOptions{}.IsWorkingDay(d)
+}
+
+// WorkingDaysBetween counts the working days from start up to, but not
+// including, end. Only the calendar days of start and end are used.
+func WorkingDaysBetween(start time.Time, end time.Time) (int, error) {
+	return WorkingDayOptions{}.WorkingDaysBetween(start, end)
+}
+
+// WorkingDaysBetweenCtx works like WorkingDaysBetween but stops with
+// ctx.Err() once ctx is cancelled. The context is checked once per year.
+func WorkingDaysBetweenCtx(ctx context.Context, start time.Time, end time.Time) (int, error) {
+	return WorkingDayOptions{}.WorkingDaysBetweenCtx(ctx, start, end)
+}
+
+// AddWorkingDays moves n working days forward from the given date, or
+// backwards if n is negative. Weekends and holidays are skipped, so adding one
+// working day to the friday before a monday holiday gives the tuesday.
+func AddWorkingDays(from time.Time, n int) (time.Time, error) {
+	return WorkingDayOptions{}.AddWorkingDays(from, n)
+}
+
+// NextWorkingDay returns the first working day strictly after the given
+// date, e.g. the tuesday for the friday before a monday holiday.
+func NextWorkingDay(from time.Time) (time.Time, error) {
+	return WorkingDayOptions{}.NextWorkingDay(from)
+}
+
+// IsWorkingDay is IsWorkingDay with the options applied.
+func (o WorkingDayOptions) IsWorkingDay(d time.Time) (bool, error) {
+	if isWeekend(d) {
+		return false, nil
+	}
+
+	holiday, err := IsHoliday(d)
+
+	if err != nil || holiday {
+		return false, err
+	}
+
+	if o.TreatEvesAsFree {
+		eves, err := GetEves(d.Year())
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, eve := range eves {
+			if isSameDay(d, eve.Date) {
+				return false, nil
+			}
+		}
+	}
+
+	for _, free := range o.ExtraFreeDays {
+		if isSameDay(d, free) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// WorkingDaysBetween is WorkingDaysBetween with the options applied.
+func (o WorkingDayOptions) WorkingDaysBetween(start time.Time, end time.Time) (int, error) {
+	return o.WorkingDaysBetweenCtx(context.Background(), start, end)
+}
+
+// WorkingDaysBetweenCtx is WorkingDaysBetweenCtx with the options applied.
+func (o WorkingDayOptions) WorkingDaysBetweenCtx(ctx context.Context, start time.Time, end time.Time) (int, error) {
+	from, to := dateOf(start), dateOf(end)
+
+	if from.After(to) {
+		return 0, fmt.Errorf("%w: %v is after %v", ErrInvalidRange, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	}
+
+	count := 0
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		if d.Equal(from) || d.YearDay() == 1 {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+		}
+
+		working, err := o.IsWorkingDay(d)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if working {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// AddWorkingDays is AddWorkingDays with the options applied.
+func (o WorkingDayOptions) AddWorkingDays(from time.Time, n int) (time.Time, error) {
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+
+	d := from
+	for n > 0 {
+		d = d.AddDate(0, 0, step)
+		working, err := o.IsWorkingDay(d)
+
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if working {
+			n--
+		}
+	}
+
+	return d, nil
+}
+
+// NextWorkingDay is NextWorkingDay with the options applied.
+func (o WorkingDayOptions) NextWorkingDay(from time.Time) (time.Time, error) {
+	return o.AddWorkingDays(from, 1)
+}
+
+// Klamdagar returns the klämdagar of the given year, i.e. the working days
+// that have a non-working day on both sides. The typical example is the
+// friday after Kristi himmelsfärdsdag.
+func Klamdagar(y int) ([]time.Time, error) {
+	if err := validateYear(y); err != nil {
+		return nil, err
+	}
+
+	var klamdagar []time.Time
+	start := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for d := start; d.Year() == y; d = d.AddDate(0, 0, 1) {
+		klamdag, err := isKlamdag(d)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if klamdag {
+			klamdagar = append(klamdagar, d)
+		}
+	}
+
+	return klamdagar, nil
+}
+
+func isKlamdag(d time.Time) (bool, error) {
+	for _, day := range []time.Time{d.AddDate(0, 0, -1), d.AddDate(0, 0, 1)} {
+		// Days outside MinYear-MaxYear are unknown and count as working days
+		if validateYear(day.Year()) != nil {
+			return false, nil
+		}
+
+		working, err := IsWorkingDay(day)
+
+		if err != nil || working {
+			return false, err
+		}
+	}
+
+	return IsWorkingDay(d)
+}
+
+// LongWeekend is a contiguous rest period of more than a regular weekend.
+// Start and End are the first and last day off, Days includes both.
+type LongWeekend struct {
+	Start time.Time
+	End   time.Time
+	Days  int
+}
+
+// LongWeekends returns the rest periods starting in the given year that
+// include a weekend and are longer than two days. Holidays and klämdagar
+// (see Klamdagar) count as days off, so Kristi himmelsfärdsdag usually gives
+// a four day period from the thursday to the sunday. A period starting in
+// december may end in the next year.
+func LongWeekends(y int) ([]LongWeekend, error) {
+	if err := validateYear(y); err != nil {
+		return nil, err
+	}
+
+	var longWeekends []LongWeekend
+	start := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for d := start; d.Year() == y; d = d.AddDate(0, 0, 1) {
+		restDay, err := isRestDay(d)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !restDay {
+			continue
+		}
+
+		end, weekend := d, false
+		for {
+			weekend = weekend || isWeekend(end)
+			next := end.AddDate(0, 0, 1)
+			restDay, err := isRestDay(next)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !restDay {
+				break
+			}
+
+			end = next
+		}
+
+		// Periods continuing from the previous year belong to that year
+		previous, err := isRestDay(d.AddDate(0, 0, -1))
+
+		if err != nil {
+			return nil, err
+		}
+
+		days := daysBetween(d, end) + 1
+		if weekend && days > 2 && !previous {
+			longWeekends = append(longWeekends, LongWeekend{Start: d, End: end, Days: days})
+		}
+
+		d = end
+	}
+
+	return longWeekends, nil
+}
+
+// isRestDay reports whether the date is a non-working day or a klämdag.
+func isRestDay(d time.Time) (bool, error) {
+	// Days outside MinYear-MaxYear are unknown and count as working days
+	if validateYear(d.Year()) != nil {
+		return false, nil
+	}
+
+	working, err := IsWorkingDay(d)
+
+	if err != nil {
+		return false, err
+	}
+
+	if !working {
+		return true, nil
+	}
+
+	return isKlamdag(d)
+}
+
+// EffectiveDaysOff returns the holidays of the given year that fall on a
+// monday-friday, i.e. the ones that actually give a day off. Holidays on the
+// same day only give one day off and only the first is returned, e.g. första
+// maj but not Kristi himmelsfärdsdag in 2008.
+func EffectiveDaysOff(y int) ([]Holiday, error) {
+	holidays, err := holidaysOf(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var daysOff []Holiday
+	for i, holiday := range holidays {
+		if i > 0 && isSameDay(holiday.Date, holidays[i-1].Date) {
+			continue
+		}
+
+		if !holiday.OnWeekend() {
+			daysOff = append(daysOff, holiday)
+		}
+	}
+
+	return daysOff, nil
+}
+
+// WeekdayHolidayCount returns how many holidays of the given year fall on a
+// monday-friday, see EffectiveDaysOff. Holidays on the same day are counted
+// once.
+func WeekdayHolidayCount(y int) (int, error) {
+	daysOff, err := EffectiveDaysOff(y)
+	return len(daysOff), err
+}
+
+// HolidaysOnWeekday returns the holidays of the given year that fall on the
+// given weekday, e.g. the monday holidays to avoid for a weekly meeting.
+func HolidaysOnWeekday(y int, wd time.Weekday) ([]Holiday, error) {
+	holidays, err := holidaysOf(y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var onWeekday []Holiday
+	for _, holiday := range holidays {
+		if holiday.Weekday() == wd {
+			onWeekday = append(onWeekday, holiday)
+		}
+	}
+
+	return onWeekday, nil
+}
+
+// goodYearThreshold is the number of weekday holidays a year needs to be good.
+// Långfredagen, annandag påsk and Kristi himmelsfärdsdag always fall on
+// weekdays and påskdagen, pingstdagen, midsommardagen and alla helgons dag
+// never do. Each of the six fixed-date holidays falls on a weekday five years
+// out of seven, so on average 3 + 6*5/7 ≈ 7.3 holidays give a day off.
+const goodYearThreshold = 8
+
+// IsGoodYear reports whether more holidays than average fall on weekdays in
+// the given year. A year is good when at least 8 holidays fall on a
+// monday-friday, see WeekdayHolidayCount. The same threshold is used for
+// years before 2005.
+func IsGoodYear(y int) (bool, error) {
+	count, err := WeekdayHolidayCount(y)
+
+	if err != nil {
+		return false, err
+	}
+
+	return count >= goodYearThreshold, nil
+}
+
+// NationalDagenOnWeekend reports whether nationaldagen falls on a saturday or
+// sunday. Sweden has no substitute day, so this is purely informational.
+// Before 2005, when NationalDagen is unset, june 6 of the year is checked.
+func (h SwedishHolidaysT) NationalDagenOnWeekend() bool {
+	return isWeekend(time.Date(h.NyarsDagen.Year(), time.June, 6, 0, 0, 0, 0, time.UTC))
+}
+
+// TrettondedagJulOnWeekend reports whether trettondedag jul falls on a
+// saturday or sunday. Sweden has no substitute day, so this is purely
+// informational.
+func (h SwedishHolidaysT) TrettondedagJulOnWeekend() bool {
+	return isWeekend(h.TrettondedagJul)
+}
+
+// OnWeekend reports whether the holiday falls on a saturday or sunday.
+func (h Holiday) OnWeekend() bool {
+	return isWeekend(h.Date)
+}
+
+func isWeekend(d time.Time) bool {
+	return d.Weekday() == time.Saturday || d.Weekday() == time.Sunday
+}
@@ -0,0 +1,113 @@
+package swedishholidays
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestKlamdagar(t *testing.T) {
+	got, err := Klamdagar(2025)
+
+	if err != nil {
+		t.Fatalf("Klamdagar(2025) returned error: %v", err)
+	}
+
+	// The fridays after första maj and Kristi himmelsfärdsdag
+	want := []time.Time{date(2025, time.May, 2), date(2025, time.May, 30)}
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Errorf("Klamdagar(2025) = %v, want %v", got, want)
+	}
+}
+
+func TestKlamdagarYearBounds(t *testing.T) {
+	for _, y := range []int{MinYear, MaxYear} {
+		if _, err := Klamdagar(y); err != nil {
+			t.Errorf("Klamdagar(%v) returned error: %v", y, err)
+		}
+
+		if _, err := LongWeekends(y); err != nil {
+			t.Errorf("LongWeekends(%v) returned error: %v", y, err)
+		}
+	}
+
+	for _, y := range []int{MinYear - 1, MaxYear + 1} {
+		if _, err := Klamdagar(y); !errors.Is(err, ErrYearOutOfRange) {
+			t.Errorf("Klamdagar(%v) returned error %v, want ErrYearOutOfRange", y, err)
+		}
+
+		if _, err := LongWeekends(y); !errors.Is(err, ErrYearOutOfRange) {
+			t.Errorf("LongWeekends(%v) returned error %v, want ErrYearOutOfRange", y, err)
+		}
+	}
+}
+
+func TestIsWorkingDay(t *testing.T) {
+	tests := []struct {
+		d    time.Time
+		want bool
+	}{
+		{date(2023, time.May, 1), false},
+		{date(2023, time.May, 2), true},
+		{date(2023, time.May, 6), false},
+		{date(2024, time.December, 24), true},
+	}
+
+	for _, tt := range tests {
+		got, err := IsWorkingDay(tt.d)
+
+		if err != nil {
+			t.Fatalf("IsWorkingDay(%v) returned error: %v", tt.d.Format(time.DateOnly), err)
+		}
+
+		if got != tt.want {
+			t.Errorf("IsWorkingDay(%v) = %v, want %v", tt.d.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
+
+func TestEffectiveDaysOffSameDay(t *testing.T) {
+	daysOff, err := EffectiveDaysOff(2008)
+
+	if err != nil {
+		t.Fatalf("EffectiveDaysOff(2008) returned error: %v", err)
+	}
+
+	count, err := WeekdayHolidayCount(2008)
+
+	if err != nil {
+		t.Fatalf("WeekdayHolidayCount(2008) returned error: %v", err)
+	}
+
+	// Första maj and Kristi himmelsfärdsdag share may 1, nyårsdagen,
+	// långfredagen, annandag påsk, nationaldagen, juldagen and annandag jul
+	// are the others
+	if len(daysOff) != 7 || count != 7 {
+		t.Errorf("EffectiveDaysOff(2008) = %v, WeekdayHolidayCount(2008) = %v, want 7 days", holidayKeys(daysOff), count)
+	}
+}
+
+func TestNationalDagenOnWeekend(t *testing.T) {
+	tests := []struct {
+		year int
+		want bool
+	}{
+		{2004, true},
+		{2003, false},
+		{2021, true},
+		{2024, false},
+	}
+
+	for _, tt := range tests {
+		holidays, err := GetHolidaysT(tt.year)
+
+		if err != nil {
+			t.Fatalf("GetHolidaysT(%v) returned error: %v", tt.year, err)
+		}
+
+		if got := holidays.NationalDagenOnWeekend(); got != tt.want {
+			t.Errorf("NationalDagenOnWeekend() for %v = %v, want %v", tt.year, got, tt.want)
+		}
+	}
+}